@@ -28,13 +28,11 @@ func WithAutomaticTableManagement(log *zap.Logger, clusterFn utils.ClusterConfig
 			return fmt.Errorf("should not have a view during startup: %q", opts.View().Name)
 		}
 
-		cluster := clusterFn()
-
-		sess, err := gocqlx.WrapSession(cluster.CreateSession())
+		sess, release, err := managementSession(opts, clusterFn)
 		if err != nil {
 			return fmt.Errorf("error creating table management session: %w", err)
 		}
-		defer sess.Close()
+		defer release()
 
 		for _, t := range opts.Types() {
 			log.Info("creating type", zap.String("type_name", t.Name))
@@ -87,11 +85,11 @@ func WithAutomaticViewManagement(log *zap.Logger, cluster utils.ClusterConfigGen
 			return fmt.Errorf("should have a view during startup")
 		}
 
-		sess, err := gocqlx.WrapSession(cluster().CreateSession())
+		sess, release, err := managementSession(opts, cluster)
 		if err != nil {
 			return fmt.Errorf("error creating table management session: %w", err)
 		}
-		defer sess.Close()
+		defer release()
 
 		return installViewFromDDL(ctx, log, sess, keyspace, opts.View())
 	})
@@ -128,11 +126,11 @@ func WithAutomaticTypeManagement(log *zap.Logger, cluster utils.ClusterConfigGen
 	return tables.WithStartupFnEx(func(ctx context.Context, keyspace string, options ...tables.StartupOption) error {
 		opts := tables.CollectStartupOptions(options)
 
-		sess, err := gocqlx.WrapSession(cluster().CreateSession())
+		sess, release, err := managementSession(opts, cluster)
 		if err != nil {
 			return fmt.Errorf("creating table management session: %w", err)
 		}
-		defer sess.Close()
+		defer release()
 
 		for _, t := range opts.Types() {
 			err = installTypeFromDDL(ctx, log, sess, keyspace, t)
@@ -178,11 +176,11 @@ func WithKeyspaceManagement(log *zap.Logger, cluster utils.ClusterConfigGenerato
 
 	return tables.WithStartupFnEx(
 		func(ctx context.Context, keyspace string, options ...tables.StartupOption) error {
-			sess, err := gocqlx.WrapSession(cluster().CreateSession())
+			sess, release, err := managementSession(tables.CollectStartupOptions(options), cluster)
 			if err != nil {
 				return fmt.Errorf("keyspace management session: %w", err)
 			}
-			defer sess.Close()
+			defer release()
 
 			keyspaceMetadata, errMetadata := DescribeKeyspaceMetadata(sess, keyspace)
 			if errMetadata != nil {
@@ -219,6 +217,25 @@ func WithGlobalNetworkAwareKeyspaceManagement(log *zap.Logger, cluster utils.Clu
 	return WithKeyspaceManagement(log, cluster, UsingNetworkReplication(replicationFactor))
 }
 
+// managementSession gets a session for running DDL, not bound to any keyspace. If the manager uses a
+// SessionPool the session is shared from the pool, otherwise a new session is created for the cluster.
+// The returned function must be called once the session is no longer needed.
+func managementSession(opts *tables.StartupOptions, cluster utils.ClusterConfigGeneratorFn) (gocqlx.Session, func(), error) {
+	if pool := opts.SessionPool(); pool != nil {
+		sess, err := pool.Acquire("")
+		if err != nil {
+			return gocqlx.Session{}, nil, err
+		}
+		return sess, func() { pool.Release("") }, nil
+	}
+
+	sess, err := gocqlx.WrapSession(cluster().CreateSession())
+	if err != nil {
+		return gocqlx.Session{}, nil, err
+	}
+	return sess, sess.Close, nil
+}
+
 func installDLL(ctx context.Context, logger *zap.Logger, sess gocqlx.Session, statements []metadata.DDLOperation) error {
 outer:
 	for _, statement := range statements {
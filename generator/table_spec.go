@@ -60,6 +60,9 @@ func CreateDDLFromTableSpecification(keyspace string, spec *metadata.TableSpecif
 			}
 		}
 		addColumnStatement := fmt.Sprintf(`ALTER TABLE %v.%v ADD %v %v`, keyspace, spec.Name, column.Name, column.CQLType)
+		if column.IsStatic {
+			addColumnStatement += " STATIC"
+		}
 		commands = append(commands, metadata.DDLOperation{
 			Description:  fmt.Sprintf("Extend the table %q with the column %q if needed.", spec.Name, column.Name),
 			Command:      addColumnStatement,
@@ -127,3 +127,86 @@ func TestGenerateTableDDLNoClustering(t *testing.T) {
 	require.NoError(t, errDDL, "Should not error generating DDL")
 	require.Len(t, ddl, len(expected))
 }
+
+// TestGenerateTableDDLStaticColumn checks static columns are added with the STATIC modifier
+func TestGenerateTableDDLStaticColumn(t *testing.T) {
+	// Arrange
+	colMarket := &metadata.ColumnSpecification{
+		Name:              "market_id",
+		CQLType:           "varchar",
+		IsPartitioningKey: true,
+	}
+	colSelection := &metadata.ColumnSpecification{
+		Name:            "selection_id",
+		CQLType:         "varchar",
+		IsClusteringKey: true,
+	}
+	colName := &metadata.ColumnSpecification{
+		Name:     "market_name",
+		CQLType:  "text",
+		IsStatic: true,
+	}
+	tableSpec := &metadata.TableSpecification{
+		Name: "market_selections",
+		Columns: []*metadata.ColumnSpecification{
+			colMarket,
+			colSelection,
+			colName,
+		},
+		Partitioning: []*metadata.PartitioningColumn{
+			{
+				Column: colMarket,
+				Order:  1,
+			},
+		},
+		Clustering: []*metadata.ClusteringColumn{
+			{
+				Column: colSelection,
+				Order:  1,
+			},
+		},
+	}
+
+	// Act
+	ddl, errDDL := generator.CreateDDLFromTableSpecification("test_keyspace", tableSpec, nil)
+
+	// Assert
+	require.NoError(t, errDDL, "Should not error generating DDL")
+	require.Len(t, ddl, 2)
+	require.Equal(t, "ALTER TABLE test_keyspace.market_selections ADD market_name text STATIC", ddl[1].Command)
+}
+
+// TestGenerateTableDDLStaticRequiresClustering checks static columns are refused on tables without clustering keys
+func TestGenerateTableDDLStaticRequiresClustering(t *testing.T) {
+	// Arrange
+	colMarket := &metadata.ColumnSpecification{
+		Name:              "market_id",
+		CQLType:           "varchar",
+		IsPartitioningKey: true,
+	}
+	colName := &metadata.ColumnSpecification{
+		Name:     "market_name",
+		CQLType:  "text",
+		IsStatic: true,
+	}
+	tableSpec := &metadata.TableSpecification{
+		Name: "markets",
+		Columns: []*metadata.ColumnSpecification{
+			colMarket,
+			colName,
+		},
+		Partitioning: []*metadata.PartitioningColumn{
+			{
+				Column: colMarket,
+				Order:  1,
+			},
+		},
+	}
+
+	// Act
+	ddl, errDDL := generator.CreateDDLFromTableSpecification("test_keyspace", tableSpec, nil)
+
+	// Assert
+	require.Nil(t, ddl, "Should not get any DDL back")
+	require.ErrorIs(t, errDDL, metadata.ErrStaticWithoutClustering)
+}
@@ -0,0 +1,137 @@
+package generator
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/gocql/gocql"
+	"github.com/scylladb/gocqlx/v3"
+
+	"github.com/zeroflucs-given/charybdis/metadata"
+	"github.com/zeroflucs-given/charybdis/tables"
+)
+
+// WithSchemaVerification lets Verify on a tables.TableManager or tables.ViewManager compare the live schema
+// with its specification, reading the schema with DescribeTableMetadata and DescribeViewMetadata.
+func WithSchemaVerification() tables.ManagerOption {
+	return tables.WithSchemaVerifier(schemaVerifier{
+		describeTable: DescribeTableMetadata,
+	})
+}
+
+// schemaVerifier is our implementation of tables.SchemaVerifier
+type schemaVerifier struct {
+	describeTable func(sess gocqlx.Session, keyspace string, tableName string) (*tableMetadata, error)
+}
+
+// VerifyTable reports how a table differs from its specification. If the keyspace doesn't exist, the table
+// is reported missing.
+func (v schemaVerifier) VerifyTable(ctx context.Context, sess gocqlx.Session, keyspace string, spec *metadata.TableSpecification) ([]tables.SchemaProblem, error) {
+	existing, err := v.describeTable(sess, keyspace, spec.Name)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return []tables.SchemaProblem{{Kind: tables.SchemaMissingObject, Name: spec.Name}}, nil
+	}
+
+	return DiffTableSchema(spec, existing.Table, existing.Indexes), nil
+}
+
+// VerifyView reports how a view differs from its specification
+func (schemaVerifier) VerifyView(ctx context.Context, sess gocqlx.Session, keyspace string, spec *metadata.ViewSpecification) ([]tables.SchemaProblem, error) {
+	existing, err := DescribeViewMetadata(sess, keyspace, spec.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return DiffViewSchema(spec, existing), nil
+}
+
+// DiffTableSchema compares a table specification with the live table and index metadata, reporting missing
+// columns, columns with different types and missing indexes. Columns and indexes in the database but not
+// in the specification are ignored, as they may belong to newer versions of a service.
+func DiffTableSchema(spec *metadata.TableSpecification, existing *gocql.TableMetadata, indexes map[string]*gocql.IndexMetadata) []tables.SchemaProblem {
+	if existing == nil {
+		return []tables.SchemaProblem{{Kind: tables.SchemaMissingObject, Name: spec.Name}}
+	}
+
+	problems := diffColumns(spec.Columns, existing.Columns)
+
+	keys := make([]string, 0, len(spec.Indexes))
+	for key := range spec.Indexes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if _, ok := indexes[key]; !ok {
+			problems = append(problems, tables.SchemaProblem{Kind: tables.SchemaMissingIndex, Name: key})
+		}
+	}
+
+	return problems
+}
+
+// DiffViewSchema compares a view specification with the live view metadata. Views select every column
+// of their table, so the columns are checked against the table specification.
+func DiffViewSchema(spec *metadata.ViewSpecification, existing *gocql.ViewMetadata) []tables.SchemaProblem {
+	if existing == nil {
+		return []tables.SchemaProblem{{Kind: tables.SchemaMissingObject, Name: spec.Name}}
+	}
+
+	return diffColumns(spec.Table.Columns, existing.Columns)
+}
+
+// diffColumns reports columns that are missing, or have a different type to their specification
+func diffColumns(columns []*metadata.ColumnSpecification, existing map[string]*gocql.ColumnMetadata) []tables.SchemaProblem {
+	var problems []tables.SchemaProblem
+	for _, column := range columns {
+		live, ok := existing[column.Name]
+		if !ok {
+			problems = append(problems, tables.SchemaProblem{Kind: tables.SchemaMissingColumn, Name: column.Name})
+			continue
+		}
+
+		if normalizeCQLType(column.CQLType) != normalizeCQLType(live.Type) {
+			problems = append(problems, tables.SchemaProblem{
+				Kind:     tables.SchemaTypeMismatch,
+				Name:     column.Name,
+				Expected: column.CQLType,
+				Actual:   live.Type,
+			})
+		}
+	}
+
+	return problems
+}
+
+// normalizeCQLType puts a CQL type string in a canonical form, as the database reports types differently
+// to how they may be written, such as text for varchar.
+func normalizeCQLType(cqlType string) string {
+	var normalized strings.Builder
+	var name strings.Builder
+	flush := func() {
+		if name.String() == "varchar" {
+			normalized.WriteString("text")
+		} else {
+			normalized.WriteString(name.String())
+		}
+		name.Reset()
+	}
+
+	for _, r := range strings.ToLower(cqlType) {
+		switch r {
+		case ' ':
+		case '<', '>', ',':
+			flush()
+			normalized.WriteRune(r)
+		default:
+			name.WriteRune(r)
+		}
+	}
+	flush()
+
+	return normalized.String()
+}
@@ -0,0 +1,83 @@
+package generator
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/scylladb/gocqlx/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeroflucs-given/charybdis/metadata"
+	"github.com/zeroflucs-given/charybdis/tables"
+)
+
+// TestVerifyTable checks the verifier reports a table missing by its own name, whether the keyspace or just
+// the table is absent, and otherwise reports how the table differs
+func TestVerifyTable(t *testing.T) {
+	// Test globals
+	spec := &metadata.TableSpecification{
+		Name: "users",
+		Columns: []*metadata.ColumnSpecification{
+			{Name: "id", CQLType: "varchar", IsPartitioningKey: true},
+			{Name: "email", CQLType: "varchar"},
+		},
+	}
+	errDescribe := errors.New("describe failed")
+
+	cases := []struct {
+		name     string
+		existing *tableMetadata
+		err      error
+		expected []tables.SchemaProblem
+	}{
+		{
+			name:     "missing keyspace",
+			expected: []tables.SchemaProblem{{Kind: tables.SchemaMissingObject, Name: "users"}},
+		},
+		{
+			name:     "missing table",
+			existing: &tableMetadata{},
+			expected: []tables.SchemaProblem{{Kind: tables.SchemaMissingObject, Name: "users"}},
+		},
+		{
+			name: "missing column",
+			existing: &tableMetadata{
+				Table: &gocql.TableMetadata{
+					Name: "users",
+					Columns: map[string]*gocql.ColumnMetadata{
+						"id": {Name: "id", Type: "text"},
+					},
+				},
+			},
+			expected: []tables.SchemaProblem{{Kind: tables.SchemaMissingColumn, Name: "email"}},
+		},
+		{
+			name: "describe error",
+			err:  errDescribe,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			var describedKeyspace, describedTable string
+			verifier := schemaVerifier{
+				describeTable: func(sess gocqlx.Session, keyspace string, tableName string) (*tableMetadata, error) {
+					describedKeyspace, describedTable = keyspace, tableName
+					return tc.existing, tc.err
+				},
+			}
+
+			// Act
+			problems, err := verifier.VerifyTable(context.Background(), gocqlx.Session{}, "accounts", spec)
+
+			// Assert
+			require.ErrorIs(t, err, tc.err, "Should return any error describing the table")
+			require.Equal(t, tc.expected, problems, "Should report the expected problems")
+			require.Equal(t, "accounts", describedKeyspace, "Should describe the given keyspace")
+			require.Equal(t, "users", describedTable, "Should describe the specified table")
+		})
+	}
+}
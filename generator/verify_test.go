@@ -0,0 +1,68 @@
+package generator_test
+
+import (
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeroflucs-given/charybdis/generator"
+	"github.com/zeroflucs-given/charybdis/metadata"
+	"github.com/zeroflucs-given/charybdis/tables"
+)
+
+// TestDiffTableSchema checks missing columns, type mismatches and missing indexes are reported
+func TestDiffTableSchema(t *testing.T) {
+	// Arrange
+	colID := &metadata.ColumnSpecification{Name: "id", CQLType: "varchar", IsPartitioningKey: true}
+	colTags := &metadata.ColumnSpecification{Name: "tags", CQLType: "map<varchar, frozen<list<int>>>"}
+	colCount := &metadata.ColumnSpecification{Name: "count", CQLType: "bigint"}
+	colEmail := &metadata.ColumnSpecification{Name: "email", CQLType: "varchar"}
+	spec := &metadata.TableSpecification{
+		Name:    "users",
+		Columns: []*metadata.ColumnSpecification{colID, colTags, colCount, colEmail},
+		Indexes: map[string]*metadata.ColumnSpecification{
+			"users_by_email": colEmail,
+			"users_by_count": colCount,
+		},
+	}
+	existing := &gocql.TableMetadata{
+		Name: "users",
+		Columns: map[string]*gocql.ColumnMetadata{
+			"id":    {Name: "id", Type: "text"},
+			"tags":  {Name: "tags", Type: "map<text, frozen<list<int>>>"},
+			"count": {Name: "count", Type: "int"},
+			"extra": {Name: "extra", Type: "text"},
+		},
+	}
+	indexes := map[string]*gocql.IndexMetadata{
+		"users_by_count": {Name: "users_by_count"},
+	}
+
+	// Act
+	problems := generator.DiffTableSchema(spec, existing, indexes)
+
+	// Assert
+	require.Equal(t, []tables.SchemaProblem{
+		{Kind: tables.SchemaTypeMismatch, Name: "count", Expected: "bigint", Actual: "int"},
+		{Kind: tables.SchemaMissingColumn, Name: "email"},
+		{Kind: tables.SchemaMissingIndex, Name: "users_by_email"},
+	}, problems, "Should report each difference")
+}
+
+// TestDiffTableSchemaMissingTable checks a missing table is reported on its own
+func TestDiffTableSchemaMissingTable(t *testing.T) {
+	// Arrange
+	spec := &metadata.TableSpecification{
+		Name:    "users",
+		Columns: []*metadata.ColumnSpecification{{Name: "id", CQLType: "varchar", IsPartitioningKey: true}},
+	}
+
+	// Act
+	problems := generator.DiffTableSchema(spec, nil, nil)
+
+	// Assert
+	require.Equal(t, []tables.SchemaProblem{
+		{Kind: tables.SchemaMissingObject, Name: "users"},
+	}, problems, "Should report the missing table")
+}
@@ -41,6 +41,18 @@ func CreateTableSpecificationFromExample(name string, example any) (*metadata.Ta
 			continue
 		}
 
+		// Fields holding a per-row TTL are not columns
+		ttlVal := field.Tag.Get(TagNameTTL)
+		if ttlVal != "" {
+			isTTL, errParse := strconv.ParseBool(ttlVal)
+			if errParse != nil {
+				return nil, fmt.Errorf("error parsing ttl struct value %v: %w", ttlVal, errParse)
+			}
+			if isTTL {
+				continue
+			}
+		}
+
 		// Determine the type associated with this
 		columnTypeString, errDetect := getTypeForField(field)
 		if errDetect != nil {
@@ -87,6 +99,17 @@ func CreateTableSpecificationFromExample(name string, example any) (*metadata.Ta
 			})
 		}
 
+		// Static?
+		staticVal := field.Tag.Get(TagNameStatic)
+		if staticVal != "" {
+			isStatic, errParse := strconv.ParseBool(staticVal)
+			if errParse != nil {
+				return nil, fmt.Errorf("error parsing static struct value %v: %w", staticVal, errParse)
+			}
+
+			columnSpec.IsStatic = isStatic
+		}
+
 		// Index?
 		indexVal := field.Tag.Get(TagNameIndex)
 		if indexVal != "" {
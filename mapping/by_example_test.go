@@ -3,6 +3,7 @@ package mapping
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -104,3 +105,56 @@ func Test_getBaseTypeForScyllaTag(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateTableSpecificationStaticColumn(t *testing.T) {
+	type Selection struct {
+		MarketID    string  `cql:"market_id" cqlpartitioning:"1"`
+		SelectionID string  `cql:"selection_id" cqlclustering:"1"`
+		MarketName  string  `cql:"market_name" cqlstatic:"true"`
+		Price       float64 `cql:"price"`
+	}
+
+	spec, err := CreateTableSpecificationFromExample("selections", &Selection{})
+	require.NoError(t, err)
+	require.NoError(t, spec.Validate())
+
+	statics := map[string]bool{}
+	for _, col := range spec.Columns {
+		statics[col.Name] = col.IsStatic
+	}
+
+	assert.Equal(t, map[string]bool{
+		"market_id":    false,
+		"selection_id": false,
+		"market_name":  true,
+		"price":        false,
+	}, statics)
+}
+
+func TestCreateTableSpecificationTTLField(t *testing.T) {
+	type Session struct {
+		SessionID string        `cql:"session_id" cqlpartitioning:"1"`
+		Expiry    time.Duration `cql:"expiry" cqlttl:"true"`
+		Lifetime  time.Duration `cql:"lifetime" cqlttl:"false"`
+	}
+
+	spec, err := CreateTableSpecificationFromExample("sessions", &Session{})
+	require.NoError(t, err)
+
+	var columns []string
+	for _, col := range spec.Columns {
+		columns = append(columns, col.Name)
+	}
+
+	assert.Equal(t, []string{"session_id", "lifetime"}, columns)
+}
+
+func TestCreateTableSpecificationInvalidTTLTag(t *testing.T) {
+	type Session struct {
+		SessionID string        `cql:"session_id" cqlpartitioning:"1"`
+		Expiry    time.Duration `cql:"expiry" cqlttl:"sometimes"`
+	}
+
+	_, err := CreateTableSpecificationFromExample("sessions", &Session{})
+	require.Error(t, err)
+}
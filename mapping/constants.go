@@ -6,6 +6,8 @@ import (
 
 	"github.com/gocql/gocql"
 	"github.com/scylladb/go-reflectx"
+
+	"github.com/zeroflucs-given/charybdis/tables"
 )
 
 const (
@@ -30,6 +32,14 @@ const (
 	// TagNameIndex indicates to create a named index over the table for a given column.
 	// Scylla only supports a singular index.
 	TagNameIndex = "cqlindex"
+
+	// TagNameStatic marks a column as static, meaning its value is shared by all rows in
+	// the partition. The tag value is parsed as a boolean, i.e. `cqlstatic:"true"`.
+	TagNameStatic = "cqlstatic"
+
+	// TagNameTTL marks a time.Duration or time.Time field as providing the TTL for each row
+	// written. Fields with this tag are never treated as columns.
+	TagNameTTL = tables.TagNameTTL
 )
 
 var tagMapper = reflectx.NewMapper(TagNameCassandra)
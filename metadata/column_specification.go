@@ -8,6 +8,7 @@ type ColumnSpecification struct {
 	CQLType           string `json:"cql_type"`        // The CQL Type string for this column
 	IsPartitioningKey bool   `json:"is_partitioning"` // Partitioning key?
 	IsClusteringKey   bool   `json:"is_clustering"`   // Clustering key?
+	IsStatic          bool   `json:"is_static"`       // Static column, shared by all rows in a partition?
 }
 
 // Validate the column specification
@@ -26,5 +27,10 @@ func (c *ColumnSpecification) Validate() error {
 		return ErrInconsistentMetadata
 	}
 
+	// Static columns live at partition level, so can't form part of the key
+	if c.IsStatic && (c.IsPartitioningKey || c.IsClusteringKey) {
+		return fmt.Errorf("%w: key columns cannot be static", ErrInconsistentMetadata)
+	}
+
 	return nil
 }
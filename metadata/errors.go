@@ -28,3 +28,10 @@ var ErrInconsistentMetadata = errors.New("inconsistent metadata")
 // ErrViewKeyUnsuitable indicates the view key definition was incorrect. It either
 // is missing a base table key, or has multiple additional fields.
 var ErrViewKeyUnsuitable = errors.New("view keys must contain all table keys, plus at most one extra")
+
+// ErrStaticWithoutClustering indicates a table declares static columns, but has no
+// clustering keys for those columns to be shared across.
+var ErrStaticWithoutClustering = errors.New("static columns require at least one clustering key")
+
+// ErrInvalidQuery indicates a named query declared on a table or view is not valid
+var ErrInvalidQuery = errors.New("invalid query")
@@ -1,7 +1,90 @@
 package metadata
 
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
 // ParameterizedQuery is a structure that describes a parameterized query
 type ParameterizedQuery struct {
 	Statement  string   `json:"statement"`  // CQL statement
 	Parameters []string `json:"parameters"` // Parameters of the query in appearance order
 }
+
+// selectPattern matches the selected columns and target of a SELECT statement
+var selectPattern = regexp.MustCompile(`(?is)^\s*SELECT\s+(.+?)\s+FROM\s+([\w."]+)`)
+
+// Validate the query, checking it selects columns of the named table or view, and that each bind marker
+// has a parameter name
+func (q *ParameterizedQuery) Validate(objectName string, columns []*ColumnSpecification) error {
+	if q == nil {
+		return ErrNoObject
+	}
+
+	match := selectPattern.FindStringSubmatch(q.Statement)
+	if match == nil {
+		return fmt.Errorf("%w: only SELECT statements are supported", ErrInvalidQuery)
+	}
+
+	// Queries must target our own table or view, as results are decoded into its records
+	target := strings.ReplaceAll(match[2], `"`, "")
+	if target != objectName && !strings.HasSuffix(target, "."+objectName) {
+		return fmt.Errorf("%w: selects from %q rather than %q", ErrInvalidQuery, target, objectName)
+	}
+
+	selected := strings.TrimSpace(match[1])
+	if selected != "*" {
+		for _, name := range strings.Split(selected, ",") {
+			name = strings.TrimSpace(name)
+			if !slices.ContainsFunc(columns, func(c *ColumnSpecification) bool { return c.Name == name }) {
+				return fmt.Errorf("%w: selects unknown column %q", ErrInvalidQuery, name)
+			}
+		}
+	}
+
+	// Each marker needs a distinct parameter name
+	markers := countBindMarkers(q.Statement)
+	if markers != len(q.Parameters) {
+		return fmt.Errorf("%w: statement has %d bind markers but %d parameters", ErrInvalidQuery, markers, len(q.Parameters))
+	}
+	for i, name := range q.Parameters {
+		if !isValidName(name) {
+			return fmt.Errorf("%w: parameter %d has no name", ErrInvalidQuery, i)
+		}
+		if slices.Index(q.Parameters, name) != i {
+			return fmt.Errorf("%w: parameter %q appears more than once", ErrInvalidQuery, name)
+		}
+	}
+
+	return nil
+}
+
+// countBindMarkers counts the ? markers in a statement, ignoring any in string literals
+func countBindMarkers(stmt string) int {
+	count := 0
+	inString := false
+	for _, r := range stmt {
+		switch {
+		case r == '\'':
+			inString = !inString
+		case r == '?' && !inString:
+			count++
+		}
+	}
+	return count
+}
+
+// validateQueries validates a set of named queries against a table or view
+func validateQueries(queries map[string]*ParameterizedQuery, objectName string, columns []*ColumnSpecification) error {
+	for name, query := range queries {
+		if !isValidName(name) {
+			return fmt.Errorf("%w: query has no name", ErrInvalidQuery)
+		}
+		if err := query.Validate(objectName, columns); err != nil {
+			return fmt.Errorf("query %q: %w", name, err)
+		}
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package metadata
 
 import (
 	"fmt"
+	"maps"
 	"slices"
 	"sort"
 
@@ -18,6 +19,7 @@ type TableSpecification struct {
 	Clustering   []*ClusteringColumn             `json:"clustering"`   // Clustering keys
 	Indexes      map[string]*ColumnSpecification `json:"indexes"`      // Indexes to create
 	CustomTypes  []*TypeSpecification            `json:"custom_types"` // If any columns use a custom type, record it here so we can create it if needed
+	Queries      map[string]*ParameterizedQuery  `json:"queries"`      // Named queries that can be run with the table-manager
 }
 
 // Canonicalize the form of the structure
@@ -47,6 +49,7 @@ func (t *TableSpecification) Clone(includeIndexes bool) *TableSpecification {
 			CQLType:           col.CQLType,
 			IsPartitioningKey: col.IsPartitioningKey,
 			IsClusteringKey:   col.IsClusteringKey,
+			IsStatic:          col.IsStatic,
 		}
 		colMap[col.Name] = cloned
 		spec.Columns = append(spec.Columns, cloned)
@@ -73,6 +76,7 @@ func (t *TableSpecification) Clone(includeIndexes bool) *TableSpecification {
 	}
 
 	spec.CustomTypes = slices.Clone(t.CustomTypes)
+	spec.Queries = maps.Clone(t.Queries)
 
 	return spec
 }
@@ -160,6 +164,15 @@ func (t *TableSpecification) Validate() error {
 		}
 	}
 
+	// Static columns are only meaningful when there are rows to share them across
+	if len(t.Clustering) == 0 {
+		for _, col := range t.Columns {
+			if col.IsStatic {
+				return fmt.Errorf("column %q: %w", col.Name, ErrStaticWithoutClustering)
+			}
+		}
+	}
+
 	// Check index column reference consistency (indexed columns need to
 	// be in our column list)
 	for _, ixCol := range t.Indexes {
@@ -174,5 +187,5 @@ func (t *TableSpecification) Validate() error {
 		}
 	}
 
-	return nil
+	return validateQueries(t.Queries, t.Name, t.Columns)
 }
@@ -11,11 +11,12 @@ import (
 
 // ViewSpecification is a specification of a view.
 type ViewSpecification struct {
-	Name         string                `json:"name"`         // Name of the view to create.
-	Table        *TableSpecification   `json:"table"`        // Table we are a view of
-	Partitioning []*PartitioningColumn `json:"partitioning"` // Partitioning keys
-	Clustering   []*ClusteringColumn   `json:"clustering"`   // Clustering keys
-	Where        string                `json:"where"`        // Where clause extension
+	Name         string                         `json:"name"`         // Name of the view to create.
+	Table        *TableSpecification            `json:"table"`        // Table we are a view of
+	Partitioning []*PartitioningColumn          `json:"partitioning"` // Partitioning keys
+	Clustering   []*ClusteringColumn            `json:"clustering"`   // Clustering keys
+	Where        string                         `json:"where"`        // Where clause extension
+	Queries      map[string]*ParameterizedQuery `json:"queries"`      // Named queries that can be run with the view-manager
 }
 
 // Validate the table specification
@@ -60,7 +61,7 @@ func (v *ViewSpecification) Validate() error {
 		return fmt.Errorf("too many residual keys: %v - %w", intersected, ErrViewKeyUnsuitable)
 	}
 
-	return nil
+	return validateQueries(v.Queries, v.Name, v.Table.Columns)
 }
 
 // ToCQLX converts this tablespec to a go-cqlx friendly metadata object.
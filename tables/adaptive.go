@@ -0,0 +1,267 @@
+package tables
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/gocql/gocql"
+	"golang.org/x/sync/errgroup"
+)
+
+// Adaptive can be passed as the concurrency of a bulk operation to have the manager's AdaptiveLimiter
+// decide how many operations run at once.
+const Adaptive = math.MinInt
+
+// AdaptiveLimiter is an AIMD concurrency limiter. The limit grows by roughly one for each limit's worth of
+// successful operations, and is cut back whenever an operation fails with a timeout or overload error, or
+// takes much longer than the baseline latency observed. A limiter is safe for concurrent use, and can be
+// shared between managers and any other fan-out code that writes to the same cluster.
+type AdaptiveLimiter struct {
+	params       adaptiveParameters
+	mu           sync.Mutex
+	limit        float64       // Current limit, fractional so that increases accumulate
+	inFlight     int           // Operations currently holding a slot
+	baseline     time.Duration // Smoothed minimum latency observed
+	lastDecrease time.Time     // When the limit was last cut back
+	wake         chan struct{} // Closed and replaced whenever a slot may have become free
+}
+
+// NewAdaptiveLimiter creates an adaptive limiter
+func NewAdaptiveLimiter(opts ...AdaptiveOption) *AdaptiveLimiter {
+	params := adaptiveParameters{
+		initialLimit:     DefaultAdaptiveInitialLimit,
+		minLimit:         1,
+		maxLimit:         DefaultAdaptiveMaxLimit,
+		backoffRatio:     DefaultAdaptiveBackoffRatio,
+		latencyTolerance: DefaultAdaptiveLatencyTolerance,
+		minSampleLatency: time.Millisecond,
+	}
+	for _, opt := range opts {
+		opt(&params)
+	}
+	params.minLimit = max(params.minLimit, 1)
+	params.maxLimit = max(params.maxLimit, params.minLimit)
+	params.initialLimit = min(max(params.initialLimit, params.minLimit), params.maxLimit)
+	if params.backoffRatio <= 0 || params.backoffRatio >= 1 {
+		params.backoffRatio = DefaultAdaptiveBackoffRatio
+	}
+	params.latencyTolerance = max(params.latencyTolerance, 1)
+
+	return &AdaptiveLimiter{
+		params: params,
+		limit:  float64(params.initialLimit),
+		wake:   make(chan struct{}),
+	}
+}
+
+// Limit gets the current concurrency limit
+func (l *AdaptiveLimiter) Limit() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return int(l.limit)
+}
+
+// InFlight gets the number of operations currently running under the limiter
+func (l *AdaptiveLimiter) InFlight() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.inFlight
+}
+
+// Do waits for a slot, runs fn, and adjusts the limit based on how long it took and the error it returned
+func (l *AdaptiveLimiter) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	release, err := l.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = fn(ctx)
+	release(err)
+	return err
+}
+
+// Acquire waits for a slot. The returned function must be called with the result of the operation once
+// it completes, to free the slot and adjust the limit.
+func (l *AdaptiveLimiter) Acquire(ctx context.Context) (func(err error), error) {
+	for {
+		l.mu.Lock()
+		if l.inFlight < int(l.limit) {
+			l.inFlight++
+			l.mu.Unlock()
+
+			st := time.Now()
+			var once sync.Once
+			return func(err error) {
+				once.Do(func() {
+					l.release(time.Since(st), err)
+				})
+			}, nil
+		}
+		wake := l.wake
+		l.mu.Unlock()
+
+		select {
+		case <-wake:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// release frees a slot and adjusts the limit based on the outcome of the operation
+func (l *AdaptiveLimiter) release(latency time.Duration, err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.inFlight--
+	previous := int(l.limit)
+
+	switch {
+	case isOverloadError(err), err == nil && l.isSlow(latency):
+		l.backOff(latency)
+	case err == nil:
+		l.limit = min(l.limit+1/l.limit, float64(l.params.maxLimit))
+	}
+
+	if err == nil {
+		l.observeLatency(latency)
+	}
+
+	l.notify(previous)
+
+	close(l.wake)
+	l.wake = make(chan struct{})
+}
+
+// observeAttempt adjusts the limit for a failed attempt within an operation that is still running, such
+// as a write timeout about to be retried. Overload errors cut the limit back as a failed operation would.
+func (l *AdaptiveLimiter) observeAttempt(err error) {
+	if !isOverloadError(err) {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	previous := int(l.limit)
+	l.backOff(0)
+	l.notify(previous)
+}
+
+// backOff cuts the limit back. Must be called with the lock held.
+func (l *AdaptiveLimiter) backOff(latency time.Duration) {
+	// Only cut back once per round trip, as operations already in flight will report the same overload
+	if time.Since(l.lastDecrease) > max(l.baseline, latency) {
+		l.limit = max(l.limit*l.params.backoffRatio, float64(l.params.minLimit))
+		l.lastDecrease = time.Now()
+	}
+}
+
+// notify passes the limit to any observer if it has changed. Must be called with the lock held.
+func (l *AdaptiveLimiter) notify(previous int) {
+	if current := int(l.limit); current != previous && l.params.limitFn != nil {
+		l.params.limitFn(current)
+	}
+}
+
+// limiterContextKey is the context key under which runBulk places its limiter
+type limiterContextKey struct{}
+
+// reportAttempt passes a failed attempt to the limiter running the operation, if any. Retry loops call
+// this for each attempt, so that the limiter sees overload as it happens rather than only once the
+// retries are exhausted.
+func reportAttempt(ctx context.Context, err error) {
+	if limiter, ok := ctx.Value(limiterContextKey{}).(*AdaptiveLimiter); ok {
+		limiter.observeAttempt(err)
+	}
+}
+
+// isSlow indicates if a latency is far enough above the baseline to indicate overload
+func (l *AdaptiveLimiter) isSlow(latency time.Duration) bool {
+	if l.baseline == 0 || latency < l.params.minSampleLatency {
+		return false
+	}
+	return float64(latency) > float64(l.baseline)*l.params.latencyTolerance
+}
+
+// observeLatency updates the baseline latency. The baseline follows new lows immediately, and drifts
+// slowly upwards so that it recovers if the cluster's unloaded latency changes.
+func (l *AdaptiveLimiter) observeLatency(latency time.Duration) {
+	if l.baseline == 0 || latency < l.baseline {
+		l.baseline = latency
+		return
+	}
+	l.baseline += (latency - l.baseline) / 100
+}
+
+// isOverloadError indicates if an error is a sign the cluster is overloaded
+func isOverloadError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var wto *gocql.RequestErrWriteTimeout
+	var rto *gocql.RequestErrReadTimeout
+	if errors.As(err, &wto) || errors.As(err, &rto) ||
+		errors.Is(err, gocql.ErrTimeoutNoResponse) || errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	var reqErr gocql.RequestError
+	if errors.As(err, &reqErr) {
+		switch reqErr.Code() {
+		case gocql.ErrCodeOverloaded, gocql.ErrCodeUnavailable, gocql.ErrCodeWriteTimeout, gocql.ErrCodeReadTimeout:
+			return true
+		}
+	}
+
+	return false
+}
+
+// runBulk runs fn for each instance, either using a fixed concurrency or an adaptive limiter
+func runBulk[T any](ctx context.Context, limiter *AdaptiveLimiter, instances []*T, concurrency int, fn func(ctx context.Context, instance *T) error) error {
+	if concurrency != Adaptive {
+		if concurrency <= 0 {
+			concurrency = DefaultBulkConcurrency
+		}
+		limiter = nil
+	}
+
+	grp, grpCtx := errgroup.WithContext(ctx)
+	if limiter == nil {
+		grp.SetLimit(concurrency)
+	} else {
+		grpCtx = context.WithValue(grpCtx, limiterContextKey{}, limiter)
+	}
+
+	var errAcquire error
+	for _, v := range instances {
+		item := v
+		if limiter == nil {
+			grp.Go(func() error {
+				return fn(grpCtx, item)
+			})
+			continue
+		}
+
+		// Acquire before starting the goroutine, so that waiting work doesn't pile up as goroutines
+		var release func(err error)
+		release, errAcquire = limiter.Acquire(grpCtx)
+		if errAcquire != nil {
+			break
+		}
+		grp.Go(func() error {
+			errItem := fn(grpCtx, item)
+			release(errItem)
+			return errItem
+		})
+	}
+
+	if errWait := grp.Wait(); errWait != nil {
+		return errWait
+	}
+	return errAcquire
+}
@@ -0,0 +1,45 @@
+package tables
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRunBulkReportsAttempts checks timed out attempts within an operation cut the limit before it completes
+func TestRunBulkReportsAttempts(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	limiter := NewAdaptiveLimiter(WithAdaptiveLimits(8, 1, 8), WithAdaptiveBackoff(0.5))
+	var limits []int
+
+	// Act - the operation retries a write timeout, then succeeds
+	err := runBulk(ctx, limiter, []*int{new(int)}, Adaptive, func(ctx context.Context, instance *int) error {
+		reportAttempt(ctx, &gocql.RequestErrWriteTimeout{})
+		limits = append(limits, limiter.Limit())
+		return nil
+	})
+
+	// Assert
+	require.NoError(t, err, "Should not error")
+	require.Equal(t, []int{4}, limits, "Should cut the limit while the operation is still running")
+}
+
+// TestReportAttemptWithoutLimiter checks attempts outside an adaptive bulk operation are ignored
+func TestReportAttemptWithoutLimiter(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	limiter := NewAdaptiveLimiter(WithAdaptiveLimits(8, 1, 8))
+
+	// Act
+	err := runBulk(ctx, limiter, []*int{new(int)}, 4, func(ctx context.Context, instance *int) error {
+		reportAttempt(ctx, &gocql.RequestErrWriteTimeout{})
+		return nil
+	})
+
+	// Assert
+	require.NoError(t, err, "Should not error")
+	require.Equal(t, 8, limiter.Limit(), "Should leave the limiter alone for fixed concurrency")
+}
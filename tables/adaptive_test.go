@@ -0,0 +1,71 @@
+package tables_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeroflucs-given/charybdis/tables"
+)
+
+// TestAdaptiveLimiterBacksOff checks the limit grows on success and shrinks on overload
+func TestAdaptiveLimiterBacksOff(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+	var observed []int
+	limiter := tables.NewAdaptiveLimiter(
+		tables.WithAdaptiveLimits(4, 2, 8),
+		tables.WithAdaptiveBackoff(0.5),
+		tables.WithAdaptiveLimitObserver(func(limit int) {
+			observed = append(observed, limit)
+		}))
+
+	// Act
+	for range 50 {
+		errDo := limiter.Do(ctx, func(ctx context.Context) error {
+			return nil
+		})
+		require.NoError(t, errDo, "Should not error on success")
+	}
+	grown := limiter.Limit()
+
+	errTimeout := limiter.Do(ctx, func(ctx context.Context) error {
+		return &gocql.RequestErrWriteTimeout{}
+	})
+	shrunk := limiter.Limit()
+
+	// Assert
+	require.Equal(t, 8, grown, "Should grow to the maximum on success")
+	require.Error(t, errTimeout, "Should pass the error back")
+	require.Equal(t, 4, shrunk, "Should halve on a write timeout")
+	require.NotEmpty(t, observed, "Should report limit changes")
+	require.Equal(t, 4, observed[len(observed)-1], "Should report the latest limit")
+	require.Zero(t, limiter.InFlight(), "Should release every slot")
+}
+
+// TestAdaptiveLimiterBlocksAtLimit checks acquiring waits for a slot and respects cancellation
+func TestAdaptiveLimiterBlocksAtLimit(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+	limiter := tables.NewAdaptiveLimiter(tables.WithAdaptiveLimits(1, 1, 1))
+
+	// Arrange
+	release, errFirst := limiter.Acquire(ctx)
+	require.NoError(t, errFirst, "Should acquire the only slot")
+
+	// Act
+	waitCtx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	_, errBlocked := limiter.Acquire(waitCtx)
+
+	release(nil)
+	releaseAgain, errAfter := limiter.Acquire(ctx)
+
+	// Assert
+	require.ErrorIs(t, errBlocked, context.DeadlineExceeded, "Should wait while the limit is reached")
+	require.NoError(t, errAfter, "Should acquire once the slot is released")
+	releaseAgain(nil)
+}
@@ -0,0 +1,251 @@
+package tables
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/scylladb/go-reflectx"
+	"golang.org/x/sync/errgroup"
+)
+
+// batchUpserter is implemented by managers that can write several rows of a partition in one batch
+type batchUpserter[T any] interface {
+	upsertBatch(ctx context.Context, instances []*T, opts ...UpsertOption) error
+}
+
+// BulkWriter performs buffered, asynchronous upserts against a table. Rows passed to Write are queued
+// and flushed in the background once enough have been queued or the flush interval passes. Each flush
+// groups rows by partition and writes them as unlogged batches. Write blocks while the queue is full.
+type BulkWriter[T any] struct {
+	manager TableManager[T]
+	mapper  *reflectx.Mapper
+	partKey []string
+	keys    keyEncoder // Builds comparable keys from partition key values
+	params  bulkWriterParameters[T]
+	rows    chan *T
+	flushes chan chan error
+	closing chan struct{} // Closed once the writer stops accepting rows
+	done    chan struct{}
+	mu      sync.RWMutex // Held by writers while they wait on the queue, so the final flush can wait for them
+	closed  atomic.Bool
+
+	failureMu sync.Mutex
+	failures  []error // Failures since the last Flush or Close
+}
+
+// NewBulkWriter creates a bulk writer over a table manager. The writer must be closed to flush any
+// remaining rows and stop its background goroutine.
+func NewBulkWriter[T any](manager TableManager[T], opts ...BulkWriterOption[T]) *BulkWriter[T] {
+	params := bulkWriterParameters[T]{
+		bufferSize:    DefaultBulkWriterBufferSize,
+		flushSize:     DefaultBulkWriterFlushSize,
+		flushInterval: DefaultBulkWriterFlushInterval,
+		batchSize:     DefaultBulkWriterBatchSize,
+		concurrency:   DefaultBulkConcurrency,
+	}
+	for _, opt := range opts {
+		opt(&params)
+	}
+	params.bufferSize = max(params.bufferSize, 1)
+	params.flushSize = max(params.flushSize, 1)
+	params.batchSize = max(params.batchSize, 1)
+	params.concurrency = max(params.concurrency, 1)
+
+	spec := manager.GetTableSpec()
+	partKey := spec.ToCQLX().Metadata().PartKey
+
+	w := &BulkWriter[T]{
+		manager: manager,
+		mapper:  managerMapper(manager.GetSession()),
+		partKey: partKey,
+		keys:    newKeyEncoder(spec, partKey),
+		params:  params,
+		rows:    make(chan *T, params.bufferSize),
+		flushes: make(chan chan error),
+		closing: make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	go w.run()
+
+	return w
+}
+
+// Write queues a row to be upserted, blocking while the queue is full
+func (w *BulkWriter[T]) Write(ctx context.Context, instance *T) error {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	if w.closed.Load() {
+		return ErrWriterClosed
+	}
+
+	select {
+	case w.rows <- instance:
+		return nil
+	case <-w.closing:
+		return ErrWriterClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Flush writes all rows queued before the call, waiting for them to complete. Any rows that failed to
+// write since the last Flush are reported in the returned error.
+func (w *BulkWriter[T]) Flush(ctx context.Context) error {
+	if w.closed.Load() {
+		return ErrWriterClosed
+	}
+
+	result := make(chan error, 1)
+	select {
+	case w.flushes <- result:
+	case <-w.done:
+		return ErrWriterClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	select {
+	case err := <-result:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Close stops accepting rows and flushes any that are queued, waiting for them to complete. Writes
+// blocked on a full queue return ErrWriterClosed. Any rows that failed to write since the last Flush
+// are reported in the returned error.
+func (w *BulkWriter[T]) Close(ctx context.Context) error {
+	if !w.closed.CompareAndSwap(false, true) {
+		return ErrWriterClosed
+	}
+	close(w.closing)
+
+	select {
+	case <-w.done:
+		return w.takeFailures()
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// run collects queued rows and flushes them until the writer is closed
+func (w *BulkWriter[T]) run() {
+	defer close(w.done)
+
+	// Flushes outlive any single Write, so they run without a caller's context
+	ctx := context.Background()
+	ticker := time.NewTicker(max(w.params.flushInterval, time.Millisecond))
+	defer ticker.Stop()
+
+	pending := make([]*T, 0, w.params.flushSize)
+	for {
+		select {
+		case <-w.closing:
+			// Writers give up once closing, so waiting out those still sending means every row
+			// accepted is in the queue before the final flush
+			w.mu.Lock()
+			w.mu.Unlock()
+			for range len(w.rows) {
+				pending = append(pending, <-w.rows)
+			}
+			w.flush(ctx, pending)
+			return
+
+		case row := <-w.rows:
+			pending = append(pending, row)
+			if len(pending) >= w.params.flushSize {
+				w.flush(ctx, pending)
+				pending = pending[:0]
+			}
+
+		case <-ticker.C:
+			w.flush(ctx, pending)
+			pending = pending[:0]
+
+		case result := <-w.flushes:
+			// Rows queued before the flush was requested are already in the buffer
+			for range len(w.rows) {
+				pending = append(pending, <-w.rows)
+			}
+			w.flush(ctx, pending)
+			pending = pending[:0]
+			result <- w.takeFailures()
+		}
+	}
+}
+
+// flush writes a set of rows, grouped by partition into batches
+func (w *BulkWriter[T]) flush(ctx context.Context, rows []*T) {
+	if len(rows) == 0 {
+		return
+	}
+
+	var batches [][]*T
+	byPartition := map[string][]int{}
+	for _, row := range rows {
+		key, err := w.keys.encode(fieldValues(w.mapper, row, w.partKey))
+		if err != nil {
+			// The row can't be written either, so it is batched alone to fail by itself
+			key = fmt.Sprintf("unencodable:%p", row)
+		}
+		indexes := byPartition[key]
+		if len(indexes) == 0 || len(batches[indexes[len(indexes)-1]]) >= w.params.batchSize {
+			indexes = append(indexes, len(batches))
+			byPartition[key] = indexes
+			batches = append(batches, make([]*T, 0, min(w.params.batchSize, len(rows))))
+		}
+		last := indexes[len(indexes)-1]
+		batches[last] = append(batches[last], row)
+	}
+
+	grp := errgroup.Group{}
+	grp.SetLimit(w.params.concurrency)
+	for _, batch := range batches {
+		grp.Go(func() error {
+			err := w.writeBatch(ctx, batch)
+			if err != nil {
+				w.fail(batch, err)
+			}
+			return nil
+		})
+	}
+	_ = grp.Wait()
+}
+
+// writeBatch writes the rows of a single partition, as a batch if the manager supports it
+func (w *BulkWriter[T]) writeBatch(ctx context.Context, batch []*T) error {
+	if upserter, ok := w.manager.(batchUpserter[T]); ok {
+		return upserter.upsertBatch(ctx, batch, w.params.upsertOpts...)
+	}
+	return w.manager.UpsertBulk(ctx, batch, w.params.concurrency, w.params.upsertOpts...)
+}
+
+// fail records rows that could not be written, and passes them to any failure handler
+func (w *BulkWriter[T]) fail(rows []*T, err error) {
+	if w.params.failureFn != nil {
+		w.params.failureFn(rows, err)
+	}
+
+	w.failureMu.Lock()
+	defer w.failureMu.Unlock()
+	w.failures = append(w.failures, err)
+}
+
+// takeFailures gets the failures since the last call as a single error, and resets them
+func (w *BulkWriter[T]) takeFailures() error {
+	w.failureMu.Lock()
+	defer w.failureMu.Unlock()
+
+	if len(w.failures) == 0 {
+		return nil
+	}
+	err := errors.Join(w.failures...)
+	w.failures = nil
+	return errors.Join(ErrBulkWriteFailed, err)
+}
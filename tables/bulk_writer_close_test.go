@@ -0,0 +1,81 @@
+package tables
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeroflucs-given/charybdis/metadata"
+)
+
+// blockedRow is a row written by blockedUpserter
+type blockedRow struct {
+	ID string `db:"id"`
+}
+
+// blockedUpserter is a manager whose batch writes block until released
+type blockedUpserter struct {
+	TableManager[blockedRow]
+	release chan struct{}
+	written atomic.Int64
+}
+
+func (b *blockedUpserter) GetSession() any {
+	return nil
+}
+
+func (b *blockedUpserter) GetTableSpec() *metadata.TableSpecification {
+	id := &metadata.ColumnSpecification{Name: "id", CQLType: "text", IsPartitioningKey: true}
+	return &metadata.TableSpecification{
+		Name:         "blocked",
+		Columns:      []*metadata.ColumnSpecification{id},
+		Partitioning: []*metadata.PartitioningColumn{{Column: id, Order: 1}},
+	}
+}
+
+func (b *blockedUpserter) upsertBatch(ctx context.Context, instances []*blockedRow, opts ...UpsertOption) error {
+	<-b.release
+	b.written.Add(int64(len(instances)))
+	return nil
+}
+
+// TestBulkWriterCloseHonoursContext checks Close gives up at its deadline while writers wait on a full queue
+func TestBulkWriterCloseHonoursContext(t *testing.T) {
+	// Arrange - the first row is taken and blocks flushing, the second fills the queue
+	ctx := context.Background()
+	manager := &blockedUpserter{release: make(chan struct{})}
+	writer := NewBulkWriter[blockedRow](manager,
+		WithBulkWriterBufferSize[blockedRow](1),
+		WithBulkWriterFlushSize[blockedRow](1))
+
+	require.NoError(t, writer.Write(ctx, &blockedRow{ID: "first"}), "Should queue the first row")
+	require.NoError(t, writer.Write(ctx, &blockedRow{ID: "second"}), "Should queue the second row")
+
+	blocked := make(chan error, 1)
+	go func() {
+		blocked <- writer.Write(ctx, &blockedRow{ID: "third"})
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	// Act
+	closeCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	st := time.Now()
+	errClose := writer.Close(closeCtx)
+	waited := time.Since(st)
+	errBlocked := <-blocked
+
+	close(manager.release)
+	errClosed := writer.Close(ctx)
+	<-writer.done
+
+	// Assert
+	require.ErrorIs(t, errClose, context.DeadlineExceeded, "Should give up at the deadline")
+	require.Less(t, waited, time.Second, "Should not wait for the flush to finish")
+	require.ErrorIs(t, errBlocked, ErrWriterClosed, "Should release writers blocked on the queue")
+	require.ErrorIs(t, errClosed, ErrWriterClosed, "Should only close once")
+	require.Equal(t, int64(2), manager.written.Load(), "Should still write the accepted rows")
+}
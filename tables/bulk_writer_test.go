@@ -0,0 +1,92 @@
+package tables_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeroflucs-given/charybdis/tables"
+)
+
+// TestBulkWriter checks queued rows are all written once the writer is closed
+func TestBulkWriter(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+	manager, err := tables.NewTableManager[OrderItem](ctx,
+		tables.WithCluster(testClusterConfig),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(OrderItemsTableSpec))
+	require.NoError(t, err, "Should not error starting up")
+
+	// Arrange - a small buffer forces writes to wait on flushes
+	writer := tables.NewBulkWriter(manager,
+		tables.WithBulkWriterBufferSize[OrderItem](10),
+		tables.WithBulkWriterFlushSize[OrderItem](25),
+		tables.WithBulkWriterBatchSize[OrderItem](7),
+		tables.WithBulkWriterFlushInterval[OrderItem](10*time.Millisecond))
+
+	// Act
+	for order := range 5 {
+		for item := range 100 {
+			errWrite := writer.Write(ctx, &OrderItem{
+				OrderID:  fmt.Sprintf("bulk-writer-order-%d", order),
+				ItemID:   fmt.Sprintf("bulk-writer-item-%d", item),
+				Quantity: item,
+			})
+			require.NoError(t, errWrite, "Should not error queueing")
+		}
+	}
+	errClose := writer.Close(ctx)
+
+	// Assert
+	require.NoError(t, errClose, "Should not error closing")
+	for order := range 5 {
+		count, errCount := manager.CountByPartitionKey(ctx, fmt.Sprintf("bulk-writer-order-%d", order))
+		require.NoError(t, errCount, "Should not error counting")
+		require.Equal(t, int64(100), count, "Should have written every row")
+	}
+	require.ErrorIs(t, writer.Write(ctx, &OrderItem{}), tables.ErrWriterClosed, "Should not accept rows once closed")
+}
+
+// TestBulkWriterReportsFailures checks rows that can't be written are passed to the failure handler
+func TestBulkWriterReportsFailures(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+	manager, err := tables.NewTableManager[OrderItem](ctx,
+		tables.WithCluster(testClusterConfig),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(OrderItemsTableSpec))
+	require.NoError(t, err, "Should not error starting up")
+
+	// Arrange - preconditions can't be batched, so every write fails
+	var mu sync.Mutex
+	var failed []*OrderItem
+	writer := tables.NewBulkWriter(manager,
+		tables.WithBulkWriterUpsertOptions[OrderItem](tables.WithSimpleUpsertIf("quantity", 1)),
+		tables.WithBulkWriterFailureHandler(func(rows []*OrderItem, err error) {
+			mu.Lock()
+			defer mu.Unlock()
+			failed = append(failed, rows...)
+		}))
+
+	// Act
+	for item := range 3 {
+		errWrite := writer.Write(ctx, &OrderItem{
+			OrderID: "bulk-writer-failure",
+			ItemID:  fmt.Sprintf("bulk-writer-item-%d", item),
+		})
+		require.NoError(t, errWrite, "Should not error queueing")
+	}
+	errFlush := writer.Flush(ctx)
+	errClose := writer.Close(ctx)
+
+	// Assert
+	require.ErrorIs(t, errFlush, tables.ErrBulkWriteFailed, "Flush should report the failure")
+	require.ErrorIs(t, errFlush, tables.ErrBatchPrecondition, "Flush should include the cause")
+	require.NoError(t, errClose, "Failures should only be reported once")
+	require.Len(t, failed, 3, "Every row should be passed to the failure handler")
+}
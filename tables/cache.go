@@ -0,0 +1,258 @@
+package tables
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gocql/gocql"
+)
+
+// Cache stores records read by a manager, so that repeated reads of the same row don't reach the database.
+// Implementations must be safe for concurrent use. NewLRUCache provides a size-bounded in-memory cache.
+type Cache interface {
+	// Get gets the value stored for a key, if present and not expired
+	Get(key string) (any, bool)
+
+	// Set stores a value for a key, expiring after the TTL
+	Set(key string, value any, ttl time.Duration)
+
+	// Delete removes the value for a key, if present
+	Delete(key string)
+}
+
+// CacheStats are counts of how reads were served by a manager's cache
+type CacheStats struct {
+	Hits         uint64 // Reads served from the cache, including not-found results
+	NegativeHits uint64 // Reads served from the cache that found no record
+	Misses       uint64 // Reads that had to query the database
+}
+
+// Kinds of cached read, each with its own keys
+const (
+	cacheByPrimaryKey   = "pk"        // GetByPrimaryKey
+	cacheByPartitionKey = "partition" // GetByPartitionKey
+)
+
+// recordCache reads records through a cache, and tracks invalidation by writes. Keys include a
+// generation, so writes that can't identify the rows they touch invalidate everything by moving to a
+// new generation.
+type recordCache[T any] struct {
+	cache       Cache         // Underlying cache
+	prefix      string        // Prefix of all our keys, as caches may be shared between managers
+	keys        keyEncoder    // Builds comparable keys from primary key values, or a partition key prefix of them
+	ttl         time.Duration // How long records are cached
+	negativeTTL time.Duration // How long not-found results are cached, or zero to not cache them
+	generation  atomic.Uint64 // Generation of our keys
+	writes      atomic.Uint64 // Count of invalidations, so reads racing a write aren't cached
+	hits        atomic.Uint64
+	negHits     atomic.Uint64
+	misses      atomic.Uint64
+}
+
+// key gets the cache key for a read. Key values are encoded as they would be bound, so that the keys
+// callers read by match those taken from records when they're written.
+func (c *recordCache[T]) key(generation uint64, kind string, keys []any) (string, error) {
+	encoded, err := c.keys.encode(keys)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s\x00%d\x00%s\x00%s", c.prefix, generation, kind, encoded), nil
+}
+
+// read gets a record from the cache, or loads it and caches the result. Not-found results are cached
+// only when negative caching is enabled. Each caller gets its own copy of the record.
+func (c *recordCache[T]) read(ctx context.Context, kind string, keys []any, load func(ctx context.Context) (*T, error)) (*T, error) {
+	if c == nil {
+		return load(ctx)
+	}
+
+	key, errKey := c.key(c.generation.Load(), kind, keys)
+	if errKey != nil {
+		// Keys that can't be encoded can't be bound either, so the load reports the problem
+		return load(ctx)
+	}
+	if value, ok := c.cache.Get(key); ok {
+		c.hits.Add(1)
+		record, _ := value.(*T)
+		if record == nil {
+			c.negHits.Add(1)
+			return new(T), gocql.ErrNotFound
+		}
+		return cloneRecord(record), nil
+	}
+	c.misses.Add(1)
+
+	// If a write invalidates anything while we're reading, what we read may already be stale
+	writes := c.writes.Load()
+	record, err := load(ctx)
+	if c.writes.Load() != writes {
+		return record, err
+	}
+
+	switch {
+	case err == nil:
+		c.cache.Set(key, cloneRecord(record), c.ttl)
+	case errors.Is(err, gocql.ErrNotFound) && c.negativeTTL > 0:
+		c.cache.Set(key, (*T)(nil), c.negativeTTL)
+	}
+
+	return record, err
+}
+
+// invalidate removes the cached reads of a row and its partition. If the keys can't be encoded,
+// everything is invalidated.
+func (c *recordCache[T]) invalidate(partitionKeys []any, primaryKeys []any) {
+	if c == nil {
+		return
+	}
+
+	generation := c.generation.Load()
+	partitionKey, errPartition := c.key(generation, cacheByPartitionKey, partitionKeys)
+	primaryKey, errPrimary := c.key(generation, cacheByPrimaryKey, primaryKeys)
+	if errPartition != nil || errPrimary != nil {
+		c.invalidateAll()
+		return
+	}
+
+	c.writes.Add(1)
+	c.cache.Delete(partitionKey)
+	c.cache.Delete(primaryKey)
+}
+
+// invalidateAll invalidates every cached read, for writes that can't identify the rows they touch
+func (c *recordCache[T]) invalidateAll() {
+	if c == nil {
+		return
+	}
+
+	c.writes.Add(1)
+	c.generation.Add(1)
+}
+
+// stats gets the cache statistics
+func (c *recordCache[T]) stats() CacheStats {
+	if c == nil {
+		return CacheStats{}
+	}
+
+	return CacheStats{
+		Hits:         c.hits.Load(),
+		NegativeHits: c.negHits.Load(),
+		Misses:       c.misses.Load(),
+	}
+}
+
+// CacheStats gets counts of how reads were served by the cache set with WithCache. Managers without a cache
+// report zero for everything.
+func (t *baseManagerImpl[T]) CacheStats() CacheStats {
+	return t.cache.stats()
+}
+
+// invalidateCached removes the cached reads of a record after it has been written
+func (t *tableManagerImpl[T]) invalidateCached(instance *T) {
+	if t.cache == nil || instance == nil {
+		return
+	}
+
+	t.cache.invalidate(
+		t.columnValues(instance, t.TableMetadata.PartKey),
+		t.columnValues(instance, slices.Concat(t.TableMetadata.PartKey, t.TableMetadata.SortKey)),
+	)
+}
+
+// LRUCache is a Cache holding up to a fixed number of entries, evicting the least recently used entry to
+// make room for new ones
+type LRUCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	order      *list.List // Entries, most recently used first
+	entries    map[string]*list.Element
+}
+
+// lruEntry is an entry in an LRUCache
+type lruEntry struct {
+	key     string
+	value   any
+	expires time.Time
+}
+
+// NewLRUCache creates an LRU cache holding up to maxEntries entries
+func NewLRUCache(maxEntries int) *LRUCache {
+	return &LRUCache{
+		maxEntries: maxEntries,
+		order:      list.New(),
+		entries:    map[string]*list.Element{},
+	}
+}
+
+// Get implements Cache
+func (c *LRUCache) Get(key string) (any, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	entry := elem.Value.(*lruEntry)
+	if time.Now().After(entry.expires) {
+		c.remove(elem)
+		return nil, false
+	}
+
+	c.order.MoveToFront(elem)
+	return entry.value, true
+}
+
+// Set implements Cache
+func (c *LRUCache) Set(key string, value any, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expires := time.Now().Add(ttl)
+	if elem, ok := c.entries[key]; ok {
+		entry := elem.Value.(*lruEntry)
+		entry.value, entry.expires = value, expires
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&lruEntry{
+		key:     key,
+		value:   value,
+		expires: expires,
+	})
+	for c.maxEntries > 0 && c.order.Len() > c.maxEntries {
+		c.remove(c.order.Back())
+	}
+}
+
+// Delete implements Cache
+func (c *LRUCache) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		c.remove(elem)
+	}
+}
+
+// Len gets the number of entries in the cache, including any that have expired but not yet been removed
+func (c *LRUCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+// remove removes an entry. The lock must be held.
+func (c *LRUCache) remove(elem *list.Element) {
+	c.order.Remove(elem)
+	delete(c.entries, elem.Value.(*lruEntry).key)
+}
@@ -0,0 +1,197 @@
+package tables
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeroflucs-given/charybdis/metadata"
+)
+
+// cachedRecord is a record type for cache tests
+type cachedRecord struct {
+	ID   string
+	Name string
+}
+
+// cachedRecordKeys encodes the keys of cached records, which are keyed by ID
+var cachedRecordKeys = newKeyEncoder(&metadata.TableSpecification{
+	Name:    "cached",
+	Columns: []*metadata.ColumnSpecification{{Name: "id", CQLType: "text"}},
+}, []string{"id"})
+
+// TestLRUCacheEviction checks the least recently used entry is evicted when the cache is full
+func TestLRUCacheEviction(t *testing.T) {
+	// Arrange
+	cache := NewLRUCache(2)
+	cache.Set("a", 1, time.Minute)
+	cache.Set("b", 2, time.Minute)
+
+	// Act
+	_, okA := cache.Get("a")
+	cache.Set("c", 3, time.Minute)
+
+	// Assert
+	require.True(t, okA, "Should find a before eviction")
+	_, okB := cache.Get("b")
+	require.False(t, okB, "Should evict b as least recently used")
+	valueA, _ := cache.Get("a")
+	require.Equal(t, 1, valueA, "Should keep a")
+	require.Equal(t, 2, cache.Len(), "Should hold the maximum number of entries")
+}
+
+// TestLRUCacheExpiry checks entries are not returned after their TTL
+func TestLRUCacheExpiry(t *testing.T) {
+	// Arrange
+	cache := NewLRUCache(10)
+	cache.Set("a", 1, time.Millisecond)
+
+	// Act
+	time.Sleep(5 * time.Millisecond)
+	_, ok := cache.Get("a")
+
+	// Assert
+	require.False(t, ok, "Should not return an expired entry")
+	require.Zero(t, cache.Len(), "Should remove the expired entry")
+}
+
+// TestRecordCacheReadThrough checks records are loaded once, copied to each caller and invalidated by writes
+func TestRecordCacheReadThrough(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	cache := &recordCache[cachedRecord]{cache: NewLRUCache(10), keys: cachedRecordKeys, prefix: "test", ttl: time.Minute}
+	loads := 0
+	load := func(ctx context.Context) (*cachedRecord, error) {
+		loads++
+		return &cachedRecord{ID: "1", Name: "first"}, nil
+	}
+
+	// Act
+	first, errFirst := cache.read(ctx, cacheByPrimaryKey, []any{"1"}, load)
+	second, errSecond := cache.read(ctx, cacheByPrimaryKey, []any{"1"}, load)
+	cache.invalidate([]any{"1"}, []any{"1"})
+	_, errThird := cache.read(ctx, cacheByPrimaryKey, []any{"1"}, load)
+
+	// Assert
+	require.NoError(t, errFirst, "Should not error on the first read")
+	require.NoError(t, errSecond, "Should not error on the second read")
+	require.NoError(t, errThird, "Should not error on the third read")
+	require.Equal(t, 2, loads, "Should load again only after invalidation")
+	require.Equal(t, *first, *second, "Should return the same record")
+	require.NotSame(t, first, second, "Should return a copy to each caller")
+	require.Equal(t, CacheStats{Hits: 1, Misses: 2}, cache.stats(), "Should count hits and misses")
+}
+
+// TestRecordCacheNegative checks not-found results are only cached with negative caching enabled
+func TestRecordCacheNegative(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	loads := 0
+	load := func(ctx context.Context) (*cachedRecord, error) {
+		loads++
+		return nil, gocql.ErrNotFound
+	}
+	withoutNegative := &recordCache[cachedRecord]{cache: NewLRUCache(10), keys: cachedRecordKeys, ttl: time.Minute}
+	withNegative := &recordCache[cachedRecord]{cache: NewLRUCache(10), keys: cachedRecordKeys, ttl: time.Minute, negativeTTL: time.Minute}
+
+	// Act
+	for range 2 {
+		_, _ = withoutNegative.read(ctx, cacheByPrimaryKey, []any{"1"}, load)
+	}
+	loadsWithout := loads
+	for range 2 {
+		_, _ = withNegative.read(ctx, cacheByPrimaryKey, []any{"1"}, load)
+	}
+	_, errCached := withNegative.read(ctx, cacheByPrimaryKey, []any{"1"}, load)
+
+	// Assert
+	require.Equal(t, 2, loadsWithout, "Should not cache not-found results by default")
+	require.Equal(t, 3, loads, "Should cache not-found results with negative caching")
+	require.ErrorIs(t, errCached, gocql.ErrNotFound, "Should report the cached not-found result")
+	require.Equal(t, CacheStats{Hits: 2, NegativeHits: 2, Misses: 1}, withNegative.stats(), "Should count negative hits")
+}
+
+// TestRecordCacheRacingWrite checks a read that overlaps a write is not cached
+func TestRecordCacheRacingWrite(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	cache := &recordCache[cachedRecord]{cache: NewLRUCache(10), keys: cachedRecordKeys, ttl: time.Minute}
+	loads := 0
+	load := func(ctx context.Context) (*cachedRecord, error) {
+		loads++
+		if loads == 1 {
+			cache.invalidateAll()
+		}
+		return &cachedRecord{ID: "1"}, nil
+	}
+
+	// Act
+	_, _ = cache.read(ctx, cacheByPrimaryKey, []any{"1"}, load)
+	_, _ = cache.read(ctx, cacheByPrimaryKey, []any{"1"}, load)
+	_, _ = cache.read(ctx, cacheByPrimaryKey, []any{"1"}, load)
+
+	// Assert
+	require.Equal(t, 2, loads, "Should not cache the read that overlapped a write")
+}
+
+// TestRecordCacheNil checks a manager without a cache reads straight through
+func TestRecordCacheNil(t *testing.T) {
+	// Arrange
+	var cache *recordCache[cachedRecord]
+
+	// Act
+	record, err := cache.read(context.Background(), cacheByPrimaryKey, nil, func(ctx context.Context) (*cachedRecord, error) {
+		return &cachedRecord{ID: "1"}, nil
+	})
+	cache.invalidate(nil, nil)
+	cache.invalidateAll()
+
+	// Assert
+	require.NoError(t, err, "Should not error")
+	require.Equal(t, "1", record.ID, "Should load the record")
+	require.Equal(t, CacheStats{}, cache.stats(), "Should report no stats")
+}
+
+// TestRecordCacheNormalisedKeys checks reads keyed by values that print differently from the stored ones,
+// such as times carrying a location and monotonic clock reading, are still invalidated by writes
+func TestRecordCacheNormalisedKeys(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	spec := &metadata.TableSpecification{
+		Name: "events",
+		Columns: []*metadata.ColumnSpecification{
+			{Name: "name", CQLType: "text"},
+			{Name: "occurred_at", CQLType: "timestamp"},
+		},
+	}
+	cache := &recordCache[cachedRecord]{
+		cache:  NewLRUCache(10),
+		keys:   newKeyEncoder(spec, []string{"name", "occurred_at"}),
+		prefix: "test",
+		ttl:    time.Minute,
+	}
+	stored := time.Date(2026, 3, 4, 5, 6, 7, 8_000_000, time.UTC)
+	now := time.Now() // Carries a monotonic clock reading
+	given := now.Add(stored.Sub(now)).In(time.FixedZone("UTC+10", 10*60*60))
+	loads := 0
+	load := func(ctx context.Context) (*cachedRecord, error) {
+		loads++
+		return &cachedRecord{ID: "1"}, nil
+	}
+
+	// Act
+	_, _ = cache.read(ctx, cacheByPrimaryKey, []any{"event", given}, load)
+	_, _ = cache.read(ctx, cacheByPrimaryKey, []any{"event", stored}, load)
+	cache.invalidate([]any{"event"}, []any{"event", stored})
+	_, _ = cache.read(ctx, cacheByPrimaryKey, []any{"event", given}, load)
+	_, errType := cache.read(ctx, cacheByPrimaryKey, []any{1, stored}, load)
+	_, _ = cache.read(ctx, cacheByPrimaryKey, []any{1, stored}, load)
+
+	// Assert
+	require.Equal(t, 4, loads, "Should share entries between equal keys, and not cache keys that can't be bound")
+	require.NoError(t, errType, "Should leave reporting badly typed keys to the load")
+	require.Equal(t, CacheStats{Hits: 1, Misses: 2}, cache.stats(), "Should only count reads through the cache")
+}
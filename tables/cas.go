@@ -0,0 +1,130 @@
+package tables
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+
+	"github.com/gocql/gocql"
+	"github.com/scylladb/gocqlx/v3/qb"
+)
+
+// GetOrInsert inserts a record if no record with the same key exists. If one does, the existing
+// record is returned from the LWT result instead. The boolean result is true if the record was inserted.
+func (t *tableManagerImpl[T]) GetOrInsert(ctx context.Context, instance *T, opts ...InsertOption) (*T, bool, error) {
+	var inserted bool
+	result, err := returnWithTracing(ctx, t.Tracer, t.Name+"/GetOrInsert", t.TraceAttributes, t.DoTracing, func(ctx context.Context) (*T, error) {
+		var errInsert error
+		var existing *T
+		existing, inserted, errInsert = t.getOrInsertInternal(ctx, instance, opts...)
+		return existing, errInsert
+	})
+	return result, inserted, err
+}
+
+// getOrInsertInternal performs the insert, returning the existing record if there is one
+func (t *tableManagerImpl[T]) getOrInsertInternal(ctx context.Context, instance *T, opts ...InsertOption) (*T, bool, error) {
+	// Lifecycle methods and pre-change hooks
+	err := t.prepareInsert(ctx, instance)
+	if err != nil {
+		return nil, false, err
+	}
+
+	// A failed write may still have been applied, so cached reads are invalidated whatever the outcome
+	defer t.invalidateCached(instance)
+
+	query := qb.Insert(t.qualifiedTableName).Columns(t.allColumnNames...).Unique()
+	if t.rowTTL != nil {
+		query = query.TTLNamed(rowTTLBindingName)
+	}
+
+	skipNil := false
+	for _, opt := range opts {
+		query = opt.applyToInsertBuilder(query)
+		skipNil = skipNil || opt.skipsNilColumns()
+	}
+
+	stmt, params := query.ToCql()
+
+	var existing T
+	keyColumns := slices.Concat(t.TableMetadata.PartKey, t.TableMetadata.SortKey)
+	budget := budgetFor(t.timeouts.lwt, opts)
+	applied, err := returnWithBudget(ctx, t.startup, t.Name+"/GetOrInsert", budget, func(retryCtx context.Context) (bool, error) {
+		return t.lwtResolver(gocql.Any).exec(ctx, "get or insert", t.faultyExecCAS(retryCtx, OperationInsert, func() (bool, error) {
+			return t.Session.ContextQuery(retryCtx, stmt, params).
+				Consistency(t.writeConsistency).
+				WithBindTransformer(t.unsetTransformer(skipNil)).
+				BindStructMap(instance, t.rowTTLBindings(instance)).
+				GetCASRelease(&existing)
+		}), lwtExpectation{
+			keys:      t.columnValues(instance, keyColumns),
+			written:   t.writtenValues(instance, t.allColumnNames, skipNil),
+			notExists: true,
+		})
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	if !applied {
+		errLoad := t.lifecycle.afterLoad(ctx, &existing)
+		if errLoad != nil {
+			return nil, false, errLoad
+		}
+		return &existing, false, nil
+	}
+
+	// Post-change hooks
+	errPost := t.runPostHooks(ctx, instance)
+	if errPost != nil {
+		return nil, false, errPost
+	}
+
+	return instance, true, nil
+}
+
+// CompareAndSwap replaces a record, provided the listed columns of the stored record still hold the
+// values they have in expected. If no columns are listed, all non-key columns are compared. Returns
+// ErrPreconditionFailed if the stored record did not match.
+func (t *tableManagerImpl[T]) CompareAndSwap(ctx context.Context, expected *T, replacement *T, columns ...string) error {
+	return doWithTracing(ctx, t.Tracer, t.Name+"/CompareAndSwap", t.TraceAttributes, t.DoTracing, func(ctx context.Context) error {
+		if expected == nil || replacement == nil {
+			return fmt.Errorf("%w: expected and replacement records are required", ErrInvalidRecord)
+		}
+
+		keyColumns := slices.Concat(t.TableMetadata.PartKey, t.TableMetadata.SortKey)
+		if !reflect.DeepEqual(t.columnValues(expected, keyColumns), t.columnValues(replacement, keyColumns)) {
+			return ErrKeyChanged
+		}
+
+		if len(columns) == 0 {
+			columns = t.nonKeyColumns
+		}
+
+		// LWT conditions can only be applied to non-key columns
+		for _, col := range columns {
+			if !slices.Contains(t.nonKeyColumns, col) {
+				return fmt.Errorf("%w: %q is not a non-key column", ErrUnknownColumn, col)
+			}
+		}
+
+		expectedVals := t.columnValues(expected, columns)
+		conditions := make([]qb.Cmp, len(columns))
+		conditionVals := map[string]any{}
+		for i, col := range columns {
+			name := expectedValueBindingPrefix + col
+			conditions[i] = qb.EqNamed(col, name)
+			conditionVals[name] = expectedVals[i]
+		}
+
+		// Lifecycle methods and pre-change hooks
+		err := t.prepareUpdate(ctx, replacement)
+		if err != nil {
+			return err
+		}
+
+		// Leave the serial consistency as the session default
+		return t.compareAndSet(ctx, replacement, t.nonKeyColumns, conditions, conditionVals, gocql.Any)
+	})
+}
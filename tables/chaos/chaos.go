@@ -0,0 +1,129 @@
+// Package chaos injects faults into table and view managers, so that tests can exercise the handling of
+// errors that a real cluster rarely produces on demand, such as write timeouts, unavailable replicas and
+// conditional writes that don't apply. Faults are chosen by rules with probabilities, driven by a seed so
+// that runs are reproducible.
+//
+//	injector := chaos.New(42, chaos.Rule{
+//		Operations:  []string{tables.OperationInsert},
+//		Probability: 0.5,
+//		Err:         chaos.WriteTimeout(gocql.Quorum),
+//	})
+//	manager, err := tables.NewTableManager[Order](ctx, ..., tables.WithFaultInjector(injector))
+package chaos
+
+import (
+	"context"
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/zeroflucs-given/charybdis/tables"
+)
+
+// Rule describes a fault to inject into matching statements
+type Rule struct {
+	Tables          []string      // Tables or views the rule applies to, or all if empty
+	Operations      []string      // Kinds of statement the rule applies to, such as tables.OperationInsert, or all if empty
+	OnlyConditional bool          // The rule only applies to conditional statements
+	Probability     float64       // Chance of the rule firing for each matching statement, from 0 to 1
+	Limit           int           // Maximum number of times the rule fires, or unlimited if zero
+	Latency         time.Duration // Delay added when the rule fires
+	Err             error         // Error returned when the rule fires, if any
+	NotApplied      bool          // Conditional statements report their condition did not hold when the rule fires
+}
+
+// matches checks if a rule applies to a statement
+func (r *Rule) matches(stmt tables.Statement) bool {
+	if len(r.Tables) > 0 && !slices.Contains(r.Tables, stmt.Table) {
+		return false
+	}
+	if len(r.Operations) > 0 && !slices.Contains(r.Operations, stmt.Operation) {
+		return false
+	}
+	if r.OnlyConditional && !stmt.Conditional {
+		return false
+	}
+	return true
+}
+
+// Injector is a tables.FaultInjector that injects faults according to a set of rules. Rules are checked
+// in order, and the first to fire for a statement decides its fault. Each check draws from a random
+// source seeded when the injector is created, so the same sequence of statements always sees the same
+// faults. Statements issued concurrently, such as by bulk operations, may reach the injector in a
+// different order between runs.
+type Injector struct {
+	mu    sync.Mutex
+	rng   *rand.Rand
+	rules []Rule
+	fired []int // Number of times each rule has fired
+	seen  int   // Number of statements seen
+}
+
+// New creates an injector from a seed and a set of rules
+func New(seed uint64, rules ...Rule) *Injector {
+	return &Injector{
+		rng:   rand.New(rand.NewPCG(seed, seed)),
+		rules: rules,
+		fired: make([]int, len(rules)),
+	}
+}
+
+// BeforeStatement implements tables.FaultInjector
+func (i *Injector) BeforeStatement(ctx context.Context, stmt tables.Statement) (bool, error) {
+	rule := i.choose(stmt)
+	if rule == nil {
+		return false, nil
+	}
+
+	if rule.Latency > 0 {
+		timer := time.NewTimer(rule.Latency)
+		defer timer.Stop()
+
+		select {
+		case <-ctx.Done():
+			return false, ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	return rule.NotApplied && stmt.Conditional, rule.Err
+}
+
+// choose picks the rule that fires for a statement, if any
+func (i *Injector) choose(stmt tables.Statement) *Rule {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.seen++
+	for idx := range i.rules {
+		rule := &i.rules[idx]
+		if !rule.matches(stmt) {
+			continue
+		}
+		if rule.Limit > 0 && i.fired[idx] >= rule.Limit {
+			continue
+		}
+
+		if i.rng.Float64() < rule.Probability {
+			i.fired[idx]++
+			return rule
+		}
+	}
+
+	return nil
+}
+
+// Fired gets the number of times each rule has fired, in the order the rules were given
+func (i *Injector) Fired() []int {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return slices.Clone(i.fired)
+}
+
+// Seen gets the number of statements the injector has seen
+func (i *Injector) Seen() int {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.seen
+}
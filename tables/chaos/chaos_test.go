@@ -0,0 +1,100 @@
+package chaos_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeroflucs-given/charybdis/tables"
+	"github.com/zeroflucs-given/charybdis/tables/chaos"
+)
+
+// TestInjectorDeterministic checks injectors with the same seed inject the same faults
+func TestInjectorDeterministic(t *testing.T) {
+	// Arrange
+	rule := chaos.Rule{
+		Probability: 0.3,
+		Err:         chaos.WriteTimeout(gocql.Quorum),
+	}
+	first := chaos.New(1234, rule)
+	second := chaos.New(1234, rule)
+	stmt := tables.Statement{Table: "orders", Operation: tables.OperationInsert}
+
+	// Act
+	var firstFaults, secondFaults []bool
+	for range 100 {
+		_, errFirst := first.BeforeStatement(context.Background(), stmt)
+		_, errSecond := second.BeforeStatement(context.Background(), stmt)
+		firstFaults = append(firstFaults, errFirst != nil)
+		secondFaults = append(secondFaults, errSecond != nil)
+	}
+
+	// Assert
+	require.Equal(t, firstFaults, secondFaults, "Should inject the same faults")
+	require.Equal(t, first.Fired(), second.Fired(), "Should fire the same number of times")
+	require.Greater(t, first.Fired()[0], 0, "Should fire sometimes")
+	require.Less(t, first.Fired()[0], 100, "Should not always fire")
+}
+
+// TestInjectorMatching checks rules only apply to the tables and operations they name, up to their limit
+func TestInjectorMatching(t *testing.T) {
+	// Arrange
+	injector := chaos.New(1,
+		chaos.Rule{
+			Tables:      []string{"orders"},
+			Operations:  []string{tables.OperationDelete},
+			Probability: 1,
+			Limit:       2,
+			Err:         chaos.WriteTimeout(gocql.Quorum),
+		},
+		chaos.Rule{
+			OnlyConditional: true,
+			Probability:     1,
+			NotApplied:      true,
+		},
+	)
+	ctx := context.Background()
+
+	// Act
+	_, errOtherTable := injector.BeforeStatement(ctx, tables.Statement{Table: "items", Operation: tables.OperationDelete})
+	_, errOtherOp := injector.BeforeStatement(ctx, tables.Statement{Table: "orders", Operation: tables.OperationInsert})
+	_, errFirst := injector.BeforeStatement(ctx, tables.Statement{Table: "orders", Operation: tables.OperationDelete})
+	_, errSecond := injector.BeforeStatement(ctx, tables.Statement{Table: "orders", Operation: tables.OperationDelete})
+	_, errThird := injector.BeforeStatement(ctx, tables.Statement{Table: "orders", Operation: tables.OperationDelete})
+	notApplied, errCAS := injector.BeforeStatement(ctx, tables.Statement{Table: "orders", Operation: tables.OperationUpdate, Conditional: true})
+
+	// Assert
+	var wto *gocql.RequestErrWriteTimeout
+	require.NoError(t, errOtherTable, "Should not fault other tables")
+	require.NoError(t, errOtherOp, "Should not fault other operations")
+	require.True(t, errors.As(errFirst, &wto), "Should inject a write timeout")
+	require.Error(t, errSecond, "Should fault up to the limit")
+	require.NoError(t, errThird, "Should stop faulting after the limit")
+	require.NoError(t, errCAS, "Should not error for not applied")
+	require.True(t, notApplied, "Should report not applied")
+	require.Equal(t, []int{2, 1}, injector.Fired(), "Should count firings")
+	require.Equal(t, 6, injector.Seen(), "Should count statements")
+}
+
+// TestInjectorLatency checks latency is added, and is bounded by the context
+func TestInjectorLatency(t *testing.T) {
+	// Arrange
+	injector := chaos.New(1, chaos.Rule{
+		Probability: 1,
+		Latency:     time.Hour,
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	// Act
+	st := time.Now()
+	_, err := injector.BeforeStatement(ctx, tables.Statement{Table: "orders", Operation: tables.OperationRead})
+
+	// Assert
+	require.ErrorIs(t, err, context.DeadlineExceeded, "Should stop waiting when the context ends")
+	require.Less(t, time.Since(st), time.Second, "Should not wait for the full latency")
+}
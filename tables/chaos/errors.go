@@ -0,0 +1,56 @@
+package chaos
+
+import (
+	"fmt"
+
+	"github.com/gocql/gocql"
+)
+
+// WriteTimeout creates the error a coordinator returns when too few replicas acknowledge a write in time.
+// Managers retry writes that fail this way.
+func WriteTimeout(consistency gocql.Consistency) error {
+	return writeTimeout(consistency, "SIMPLE")
+}
+
+// CASWriteTimeout creates the error a coordinator returns when a conditional write times out. The write
+// may or may not have been applied.
+func CASWriteTimeout(consistency gocql.Consistency) error {
+	return writeTimeout(consistency, "CAS")
+}
+
+// writeTimeout creates a write timeout error for a type of write
+func writeTimeout(consistency gocql.Consistency, writeType string) error {
+	err := &gocql.RequestErrWriteTimeout{
+		WriteType:   writeType,
+		Consistency: consistency,
+		BlockFor:    2,
+		Received:    1,
+	}
+	err.Code = gocql.ErrCodeWriteTimeout
+	err.Message = fmt.Sprintf("injected %s write timeout at %s", writeType, consistency)
+	return err
+}
+
+// ReadTimeout creates the error a coordinator returns when too few replicas respond to a read in time
+func ReadTimeout(consistency gocql.Consistency) error {
+	err := &gocql.RequestErrReadTimeout{
+		Consistency: consistency,
+		BlockFor:    2,
+		Received:    1,
+	}
+	err.Code = gocql.ErrCodeReadTimeout
+	err.Message = fmt.Sprintf("injected read timeout at %s", consistency)
+	return err
+}
+
+// Unavailable creates the error a coordinator returns when too few replicas are alive to attempt a request
+func Unavailable(consistency gocql.Consistency) error {
+	err := &gocql.RequestErrUnavailable{
+		Consistency: consistency,
+		Required:    2,
+		Alive:       1,
+	}
+	err.Code = gocql.ErrCodeUnavailable
+	err.Message = fmt.Sprintf("injected unavailable at %s", consistency)
+	return err
+}
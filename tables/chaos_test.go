@@ -0,0 +1,295 @@
+package tables_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/scylladb/gocqlx/v3/qb"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/zeroflucs-given/charybdis/generator"
+	"github.com/zeroflucs-given/charybdis/tables"
+	"github.com/zeroflucs-given/charybdis/tables/chaos"
+)
+
+// TestChaosRetriesWriteTimeouts checks writes are retried after write timeouts, and that other errors
+// are returned
+func TestChaosRetriesWriteTimeouts(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+	logger := zaptest.NewLogger(t)
+	injector := chaos.New(7,
+		chaos.Rule{
+			Operations:  []string{tables.OperationDelete},
+			Probability: 1,
+			Limit:       3,
+			Err:         chaos.WriteTimeout(gocql.Quorum),
+		},
+		chaos.Rule{
+			Operations:  []string{tables.OperationInsert},
+			Probability: 0.2,
+			Err:         chaos.Unavailable(gocql.Quorum),
+		},
+	)
+
+	manager, err := tables.NewTableManager[OrderItem](ctx,
+		tables.WithCluster(testClusterConfig),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(OrderItemsTableSpec),
+		tables.WithFaultInjector(injector),
+		generator.WithAutomaticTableManagement(logger, testClusterConfig))
+	require.NoError(t, err, "Should not error starting up")
+	defer manager.Close()
+
+	var items []*OrderItem
+	for i := range 50 {
+		items = append(items, &OrderItem{OrderID: "chaos-1", ItemID: fmt.Sprintf("item-%02d", i), Quantity: 1})
+	}
+
+	// Act
+	errBulk := manager.InsertBulk(ctx, items, 1)
+	errDelete := manager.DeleteByPrimaryKey(ctx, "chaos-1", "item-00")
+
+	// Assert
+	var unavailable *gocql.RequestErrUnavailable
+	require.ErrorAs(t, errBulk, &unavailable, "Bulk insert should fail when replicas are unavailable")
+	require.NoError(t, errDelete, "Delete should succeed after retrying write timeouts")
+	require.Equal(t, 3, injector.Fired()[0], "Delete should have timed out three times")
+}
+
+// TestChaosResolvesLWTTimeouts checks a conditional write that times out is read back, and retried
+// when it is found not to have been applied
+func TestChaosResolvesLWTTimeouts(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+	logger := zaptest.NewLogger(t)
+	injector := chaos.New(7, chaos.Rule{
+		Operations:      []string{tables.OperationInsert},
+		OnlyConditional: true,
+		Probability:     1,
+		Limit:           1,
+		Err:             chaos.CASWriteTimeout(gocql.Quorum),
+	})
+
+	manager, err := tables.NewTableManager[OrderItem](ctx,
+		tables.WithCluster(testClusterConfig),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(OrderItemsTableSpec),
+		tables.WithFaultInjector(injector),
+		generator.WithAutomaticTableManagement(logger, testClusterConfig))
+	require.NoError(t, err, "Should not error starting up")
+	defer manager.Close()
+
+	item := &OrderItem{OrderID: "chaos-lwt-1", ItemID: "item-1", Quantity: 3}
+
+	// Act
+	_, inserted, errInsert := manager.GetOrInsert(ctx, item)
+
+	// Assert
+	require.NoError(t, errInsert, "Should resolve the timeout by reading back")
+	require.True(t, inserted, "Should insert after finding the timed out write was not applied")
+	require.Equal(t, []int{1}, injector.Fired(), "Should have injected the timeout")
+}
+
+// TestChaosAdaptiveSeesRetriedTimeouts checks write timeouts that are retried still cut the adaptive limit
+func TestChaosAdaptiveSeesRetriedTimeouts(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+	logger := zaptest.NewLogger(t)
+	injector := chaos.New(7, chaos.Rule{
+		Operations:  []string{tables.OperationInsert},
+		Probability: 1,
+		Limit:       3,
+		Err:         chaos.WriteTimeout(gocql.Quorum),
+	})
+
+	lowest := 8
+	limiter := tables.NewAdaptiveLimiter(
+		tables.WithAdaptiveLimits(8, 1, 8),
+		tables.WithAdaptiveLimitObserver(func(limit int) {
+			lowest = min(lowest, limit)
+		}))
+
+	manager, err := tables.NewTableManager[OrderItem](ctx,
+		tables.WithCluster(testClusterConfig),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(OrderItemsTableSpec),
+		tables.WithFaultInjector(injector),
+		tables.WithAdaptiveLimiter(limiter),
+		generator.WithAutomaticTableManagement(logger, testClusterConfig))
+	require.NoError(t, err, "Should not error starting up")
+	defer manager.Close()
+
+	var items []*OrderItem
+	for i := range 10 {
+		items = append(items, &OrderItem{OrderID: "chaos-adaptive-1", ItemID: fmt.Sprintf("item-%02d", i), Quantity: 1})
+	}
+
+	// Act
+	errBulk := manager.InsertBulk(ctx, items, tables.Adaptive)
+
+	// Assert
+	require.NoError(t, errBulk, "Bulk insert should succeed after retrying write timeouts")
+	require.Equal(t, 3, injector.Fired()[0], "Inserts should have timed out three times")
+	require.Less(t, lowest, 8, "Retried timeouts should cut the limit")
+}
+
+// newCASTimeoutManager creates an order items manager whose first conditional statement of the given
+// operation times out before it is sent
+func newCASTimeoutManager(t *testing.T, operation string) (tables.TableManager[OrderItem], *chaos.Injector) {
+	ctx := context.Background()
+	logger := zaptest.NewLogger(t)
+	injector := chaos.New(7, chaos.Rule{
+		Operations:      []string{operation},
+		OnlyConditional: true,
+		Probability:     1,
+		Limit:           1,
+		Err:             chaos.CASWriteTimeout(gocql.Quorum),
+	})
+
+	manager, err := tables.NewTableManager[OrderItem](ctx,
+		tables.WithCluster(testClusterConfig),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(OrderItemsTableSpec),
+		tables.WithFaultInjector(injector),
+		generator.WithAutomaticTableManagement(logger, testClusterConfig))
+	require.NoError(t, err, "Should not error starting up")
+	t.Cleanup(manager.Close)
+
+	return manager, injector
+}
+
+// TestChaosInsertTimeoutRetried checks an insert that times out is retried when the row is found missing
+func TestChaosInsertTimeoutRetried(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+	manager, injector := newCASTimeoutManager(t, tables.OperationInsert)
+
+	item := &OrderItem{OrderID: "chaos-insert-1", ItemID: "item-1", Quantity: 3}
+
+	// Act
+	errInsert := manager.Insert(ctx, item)
+	stored, errGet := manager.GetByPrimaryKey(ctx, "chaos-insert-1", "item-1")
+
+	// Assert
+	require.NoError(t, errInsert, "Should resolve the timeout by reading back")
+	require.Equal(t, []int{1}, injector.Fired(), "Should have injected the timeout")
+	require.NoError(t, errGet, "Should not error reading back")
+	require.Equal(t, item, stored, "Should have inserted the row on retry")
+}
+
+// TestChaosInsertTimeoutApplied checks an insert that times out is reported as applied when the row
+// already holds the values being written
+func TestChaosInsertTimeoutApplied(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+	manager, injector := newCASTimeoutManager(t, tables.OperationInsert)
+
+	// Arrange
+	item := &OrderItem{OrderID: "chaos-insert-2", ItemID: "item-1", Quantity: 3}
+	errArrange := manager.InsertOrReplace(ctx, item)
+	require.NoError(t, errArrange, "Should not error arranging the row")
+
+	// Act
+	errInsert := manager.Insert(ctx, &OrderItem{OrderID: "chaos-insert-2", ItemID: "item-1", Quantity: 3})
+
+	// Assert
+	require.NoError(t, errInsert, "Should treat the matching row as the timed out write")
+	require.Equal(t, []int{1}, injector.Fired(), "Should have injected the timeout")
+}
+
+// TestChaosInsertTimeoutUnknown checks an insert that times out returns ErrOutcomeUnknown when the row
+// holds other values
+func TestChaosInsertTimeoutUnknown(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+	manager, injector := newCASTimeoutManager(t, tables.OperationInsert)
+
+	// Arrange
+	errArrange := manager.InsertOrReplace(ctx, &OrderItem{OrderID: "chaos-insert-3", ItemID: "item-1", Quantity: 1})
+	require.NoError(t, errArrange, "Should not error arranging the row")
+
+	// Act
+	errInsert := manager.Insert(ctx, &OrderItem{OrderID: "chaos-insert-3", ItemID: "item-1", Quantity: 3})
+
+	// Assert
+	require.ErrorIs(t, errInsert, tables.ErrOutcomeUnknown, "Should report the outcome as unknown")
+	require.Equal(t, []int{1}, injector.Fired(), "Should have injected the timeout")
+}
+
+// TestChaosUpdateTimeoutRetried checks a compare-and-set update that times out is retried when its
+// condition still holds
+func TestChaosUpdateTimeoutRetried(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+	manager, injector := newCASTimeoutManager(t, tables.OperationUpdate)
+
+	// Arrange
+	errArrange := manager.InsertOrReplace(ctx, &OrderItem{OrderID: "chaos-update-1", ItemID: "item-1", Quantity: 1})
+	require.NoError(t, errArrange, "Should not error arranging the row")
+
+	// Act
+	calls := 0
+	errUpdate := manager.UpdateFunc(ctx, []any{"chaos-update-1", "item-1"}, func(current *OrderItem) (*OrderItem, error) {
+		calls++
+		current.Quantity++
+		return current, nil
+	})
+	stored, errGet := manager.GetByPrimaryKey(ctx, "chaos-update-1", "item-1")
+
+	// Assert
+	require.NoError(t, errUpdate, "Should resolve the timeout by reading back")
+	require.Equal(t, 1, calls, "Should retry the write without running the function again")
+	require.Equal(t, []int{1}, injector.Fired(), "Should have injected the timeout")
+	require.NoError(t, errGet, "Should not error reading back")
+	require.Equal(t, 2, stored.Quantity, "Should have applied the update once")
+}
+
+// TestChaosConditionalDeleteTimeoutRetried checks a conditional delete that times out is retried when
+// the row is still there with the expected values
+func TestChaosConditionalDeleteTimeoutRetried(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+	manager, injector := newCASTimeoutManager(t, tables.OperationDelete)
+
+	// Arrange
+	errArrange := manager.InsertOrReplace(ctx, &OrderItem{OrderID: "chaos-delete-1", ItemID: "item-1", Quantity: 3})
+	require.NoError(t, errArrange, "Should not error arranging the row")
+
+	// Act
+	errDelete := manager.DeleteUsingOptions(ctx,
+		tables.WithDeletionKey("order_id", "chaos-delete-1"),
+		tables.WithDeletionKey("item_id", "item-1"),
+		tables.WithDeleteIf(qb.Eq("quantity"), 3))
+	stored, errGet := manager.GetByPrimaryKey(ctx, "chaos-delete-1", "item-1")
+
+	// Assert
+	require.NoError(t, errDelete, "Should resolve the timeout by reading back")
+	require.Equal(t, []int{1}, injector.Fired(), "Should have injected the timeout")
+	require.NoError(t, errGet, "Should not error reading back")
+	require.Nil(t, stored, "Should have deleted the row on retry")
+}
+
+// TestChaosDeleteIfExistsTimeoutRetried checks a delete and return that times out is retried when the
+// row still exists, and still returns the row
+func TestChaosDeleteIfExistsTimeoutRetried(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+	manager, injector := newCASTimeoutManager(t, tables.OperationDelete)
+
+	// Arrange
+	item := &OrderItem{OrderID: "chaos-delete-2", ItemID: "item-1", Quantity: 3}
+	errArrange := manager.InsertOrReplace(ctx, item)
+	require.NoError(t, errArrange, "Should not error arranging the row")
+
+	// Act
+	removed, errDelete := manager.DeleteAndReturn(ctx, []tables.DeleteOption{tables.WithDeleteIfExists()}, "chaos-delete-2", "item-1")
+
+	// Assert
+	require.NoError(t, errDelete, "Should resolve the timeout by reading back")
+	require.Equal(t, item, removed, "Should return the removed row")
+	require.Equal(t, []int{1}, injector.Fired(), "Should have injected the timeout")
+}
@@ -0,0 +1,123 @@
+package tables
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// readCoalescer shares the result of a read between callers making the same read at the same time, so
+// that only one query is sent. The shared read only stops early if every caller waiting on it gives up,
+// so the caller that started it can cancel without failing the others.
+type readCoalescer struct {
+	mu      sync.Mutex
+	flights map[string]*readFlight
+}
+
+// readFlight is a read in progress, shared by one or more callers
+type readFlight struct {
+	done    chan struct{}      // Closed once the read has completed
+	result  any                // Result of the read, only set before done is closed
+	err     error              // Error from the read, only set before done is closed
+	waiters int                // Callers still waiting on the read
+	cancel  context.CancelFunc // Stops the read
+}
+
+// newReadCoalescer creates a read coalescer
+func newReadCoalescer() *readCoalescer {
+	return &readCoalescer{
+		flights: map[string]*readFlight{},
+	}
+}
+
+// do runs a read, or joins one already in progress with the same key. The read runs with the values of
+// the context of the caller that started it, but is only cancelled once every caller has given up.
+func (c *readCoalescer) do(ctx context.Context, key string, read func(ctx context.Context) (any, error)) (any, error) {
+	c.mu.Lock()
+	flight, ok := c.flights[key]
+	if !ok {
+		flightCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		flight = &readFlight{
+			done:   make(chan struct{}),
+			cancel: cancel,
+		}
+		c.flights[key] = flight
+
+		go func() {
+			defer cancel()
+			result, err := read(flightCtx)
+
+			c.mu.Lock()
+			if c.flights[key] == flight {
+				delete(c.flights, key)
+			}
+			flight.result, flight.err = result, err
+			c.mu.Unlock()
+			close(flight.done)
+		}()
+	}
+	flight.waiters++
+	c.mu.Unlock()
+
+	select {
+	case <-flight.done:
+		return flight.result, flight.err
+	case <-ctx.Done():
+		c.mu.Lock()
+		flight.waiters--
+		if flight.waiters == 0 {
+			// Nobody is left to use the result, so stop the read and make sure later callers start afresh
+			flight.cancel()
+			if c.flights[key] == flight {
+				delete(c.flights, key)
+			}
+		}
+		c.mu.Unlock()
+		return nil, ctx.Err()
+	}
+}
+
+// coalesceKey identifies a read by its statement and primary key values, or a partition key prefix of
+// them. Values are encoded as they would be bound, so reads of the same row share a key however the
+// caller holds its key values.
+func (t *baseManagerImpl[T]) coalesceKey(stmt string, keys ...any) string {
+	encoded, err := t.keys.encode(keys)
+	if err != nil {
+		// Values that can't be bound fail the read anyway, so sharing it only matters for equal values
+		return coalesceBindingsKey(stmt, keys...)
+	}
+	return stmt + "\x00" + encoded
+}
+
+// coalesceBindingsKey identifies a read by its statement and bindings, for reads that don't bind key
+// values. Pointers are followed, so the same values bound through different pointers share a key.
+func coalesceBindingsKey(stmt string, bindings ...any) string {
+	var sb strings.Builder
+	sb.WriteString(stmt)
+	sb.WriteString("\x00")
+	for _, v := range bindings {
+		if rv := reflect.ValueOf(v); rv.Kind() == reflect.Pointer && !rv.IsNil() {
+			v = rv.Elem().Interface()
+		}
+		s := fmt.Sprintf("%#v", v)
+		fmt.Fprintf(&sb, "%d:%s|", len(s), s)
+	}
+	return sb.String()
+}
+
+// coalesceGet runs a single row read, sharing it with any identical reads in progress if read coalescing
+// is enabled. Each caller gets its own copy of the record.
+func (t *baseManagerImpl[T]) coalesceGet(ctx context.Context, key string, read func(ctx context.Context) (*T, error)) (*T, error) {
+	if t.coalescer == nil {
+		return read(ctx)
+	}
+
+	result, err := t.coalescer.do(ctx, key, func(ctx context.Context) (any, error) {
+		return read(ctx)
+	})
+
+	record, _ := result.(*T)
+	return cloneRecord(record), err
+}
@@ -0,0 +1,153 @@
+package tables
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeroflucs-given/charybdis/metadata"
+)
+
+// TestReadCoalescing checks concurrent identical reads share a single read
+func TestReadCoalescing(t *testing.T) {
+	// Arrange
+	coalescer := newReadCoalescer()
+	release := make(chan struct{})
+	var reads atomic.Int32
+	read := func(ctx context.Context) (any, error) {
+		reads.Add(1)
+		<-release
+		return "row", nil
+	}
+
+	// Act
+	var wg sync.WaitGroup
+	results := make([]any, 10)
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i], _ = coalescer.do(context.Background(), "key", read)
+		}()
+	}
+	require.Eventually(t, func() bool {
+		coalescer.mu.Lock()
+		defer coalescer.mu.Unlock()
+		return coalescer.flights["key"] != nil && coalescer.flights["key"].waiters == len(results)
+	}, time.Second, time.Millisecond, "All callers should join the read")
+	close(release)
+	wg.Wait()
+
+	// Assert
+	require.Equal(t, int32(1), reads.Load(), "Should read once")
+	for _, result := range results {
+		require.Equal(t, "row", result, "Each caller should get the result")
+	}
+	require.Empty(t, coalescer.flights, "Should forget the read once complete")
+}
+
+// TestReadCoalescingLeaderCancelled checks the caller that started a read can give up without
+// failing the others, and that the read is cancelled once every caller has given up
+func TestReadCoalescingLeaderCancelled(t *testing.T) {
+	// Arrange
+	coalescer := newReadCoalescer()
+	release := make(chan struct{})
+	readCancelled := make(chan struct{})
+	read := func(ctx context.Context) (any, error) {
+		select {
+		case <-release:
+			return "row", nil
+		case <-ctx.Done():
+			close(readCancelled)
+			return nil, ctx.Err()
+		}
+	}
+
+	leaderCtx, cancelLeader := context.WithCancel(context.Background())
+	followerCtx, cancelFollower := context.WithCancel(context.Background())
+	lateCtx, cancelLate := context.WithCancel(context.Background())
+	defer cancelLate()
+
+	// Act
+	leaderDone := make(chan error)
+	go func() {
+		_, err := coalescer.do(leaderCtx, "key", read)
+		leaderDone <- err
+	}()
+	followerDone := make(chan error)
+	require.Eventually(t, func() bool {
+		coalescer.mu.Lock()
+		defer coalescer.mu.Unlock()
+		return coalescer.flights["key"] != nil
+	}, time.Second, time.Millisecond, "Leader should start the read")
+	go func() {
+		_, err := coalescer.do(followerCtx, "key", read)
+		followerDone <- err
+	}()
+	require.Eventually(t, func() bool {
+		coalescer.mu.Lock()
+		defer coalescer.mu.Unlock()
+		return coalescer.flights["key"].waiters == 2
+	}, time.Second, time.Millisecond, "Follower should join the read")
+
+	cancelLeader()
+	errLeader := <-leaderDone
+
+	var cancelledEarly bool
+	select {
+	case <-readCancelled:
+		cancelledEarly = true
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	cancelFollower()
+	errFollower := <-followerDone
+	<-readCancelled
+
+	close(release)
+	result, errLate := coalescer.do(lateCtx, "key", read)
+
+	// Assert
+	require.ErrorIs(t, errLeader, context.Canceled, "Leader should see its own cancellation")
+	require.False(t, cancelledEarly, "Read should continue while the follower waits")
+	require.ErrorIs(t, errFollower, context.Canceled, "Follower should see its own cancellation")
+	require.NoError(t, errLate, "A later caller should start a fresh read")
+	require.Equal(t, "row", result, "A later caller should get the fresh result")
+}
+
+// TestCoalesceKeys checks reads of the same row share a key however the key values are held, and that
+// different reads don't
+func TestCoalesceKeys(t *testing.T) {
+	// Arrange
+	spec := &metadata.TableSpecification{
+		Name: "events",
+		Columns: []*metadata.ColumnSpecification{
+			{Name: "name", CQLType: "text"},
+			{Name: "occurred_at", CQLType: "timestamp"},
+		},
+	}
+	manager := &baseManagerImpl[cachedRecord]{keys: newKeyEncoder(spec, []string{"name", "occurred_at"})}
+	stored := time.Date(2026, 3, 4, 5, 6, 7, 8_000_000, time.UTC)
+	given := stored.In(time.FixedZone("UTC+10", 10*60*60))
+	name, otherName := "event", "event"
+
+	// Act
+	storedKey := manager.coalesceKey("SELECT", "event", stored)
+	givenKey := manager.coalesceKey("SELECT", &name, given)
+	otherKey := manager.coalesceKey("SELECT", "other", stored)
+	prefixKey := manager.coalesceKey("SELECT", "event")
+	bindingsKey := coalesceBindingsKey("SELECT", &name, 1)
+	otherBindingsKey := coalesceBindingsKey("SELECT", &otherName, 1)
+	stringBindingsKey := coalesceBindingsKey("SELECT", "event", "1")
+
+	// Assert
+	require.Equal(t, storedKey, givenKey, "Should share reads of equal key values")
+	require.NotEqual(t, storedKey, otherKey, "Should not share reads of other keys")
+	require.NotEqual(t, storedKey, prefixKey, "Should not share reads of a key prefix")
+	require.Equal(t, bindingsKey, otherBindingsKey, "Should follow pointers in bindings")
+	require.NotEqual(t, bindingsKey, stringBindingsKey, "Should tell bindings of different types apart")
+}
@@ -1,14 +1,70 @@
 package tables
 
+import "time"
+
 const (
+	// DefaultAdaptiveBackoffRatio is the ratio an AdaptiveLimiter multiplies its limit by when
+	// it detects overload.
+	DefaultAdaptiveBackoffRatio = 0.9
+
+	// DefaultAdaptiveInitialLimit is the limit an AdaptiveLimiter starts from.
+	DefaultAdaptiveInitialLimit = 16
+
+	// DefaultAdaptiveLatencyTolerance is how many times the baseline latency an operation may take
+	// before an AdaptiveLimiter treats it as a sign of overload.
+	DefaultAdaptiveLatencyTolerance = 4.0
+
+	// DefaultAdaptiveMaxLimit is the highest limit an AdaptiveLimiter will grow to.
+	DefaultAdaptiveMaxLimit = 512
+
 	// DefaultBulkConcurrency is the number of concurrent updates or actions
 	// permitted at once when using bulk operations.
 	DefaultBulkConcurrency = 64
 
+	// DefaultBulkWriterBatchSize is the most rows for a single partition that a BulkWriter
+	// writes in one unlogged batch.
+	DefaultBulkWriterBatchSize = 50
+
+	// DefaultBulkWriterBufferSize is the number of rows a BulkWriter queues before Write blocks.
+	DefaultBulkWriterBufferSize = 10000
+
+	// DefaultBulkWriterFlushInterval is the longest a BulkWriter holds a row before flushing it.
+	DefaultBulkWriterFlushInterval = 100 * time.Millisecond
+
+	// DefaultBulkWriterFlushSize is the number of queued rows that causes a BulkWriter to flush.
+	DefaultBulkWriterFlushSize = 1000
+
+	// DefaultLoaderMaxBatch is the number of distinct keys that causes a Loader to dispatch
+	// a batch without waiting for the rest of the window.
+	DefaultLoaderMaxBatch = 100
+
+	// DefaultLoaderWait is how long a Loader collects lookups before dispatching them.
+	DefaultLoaderWait = 2 * time.Millisecond
+
 	// DefaultPageSize is the number of records fetched in a page.
 	DefaultPageSize = 100
 
+	// DefaultUpdateFuncAttempts is the number of attempts UpdateFunc makes before giving up
+	// on a conflicting record.
+	DefaultUpdateFuncAttempts = 5
+
+	// DefaultUpdateFuncInitialBackoff is the delay before UpdateFunc first retries.
+	DefaultUpdateFuncInitialBackoff = 10 * time.Millisecond
+
+	// DefaultUpdateFuncMaxBackoff is the longest delay between UpdateFunc retries.
+	DefaultUpdateFuncMaxBackoff = time.Second
+
+	// DefaultStartupInitialBackoff is the delay before manager startup is first retried.
+	DefaultStartupInitialBackoff = 100 * time.Millisecond
+
+	// DefaultStartupMaxBackoff is the longest delay between manager startup retries.
+	DefaultStartupMaxBackoff = 30 * time.Second
+
 	// TracingModuleName is the name of the module to show in any OpenTelemetry
 	// trace records for this package.
 	TracingModuleName = "charydbis"
+
+	// TagNameTTL marks a time.Duration or time.Time field on a record as providing the TTL for that
+	// row. The field is not treated as a column. The tag value is parsed as a boolean, i.e. `cqlttl:"true"`.
+	TagNameTTL = "cqlttl"
 )
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"time"
 
 	"github.com/gocql/gocql"
@@ -16,6 +18,7 @@ func (t *tableManagerImpl[T]) Delete(ctx context.Context, instance *T) error {
 	if instance == nil {
 		return nil // nothing to delete
 	}
+	defer t.invalidateCached(instance)
 
 	return doWithTracing(ctx, t.Tracer, t.Name+"/DeleteByObject", t.TraceAttributes, t.DoTracing, func(ctx context.Context) error {
 		// Pre-delete hooks
@@ -30,37 +33,40 @@ func (t *tableManagerImpl[T]) Delete(ctx context.Context, instance *T) error {
 			}
 		}
 
-		st := time.Now()
-		retryCtx, cancel := context.WithTimeout(ctx, t.queryTimeout)
-		defer cancel()
-
-		q := t.Table.
-			DeleteBuilder().
-			Existing().
-			QueryContext(retryCtx, t.Session).
-			Consistency(t.writeConsistency).
-			BindStruct(instance)
+		// Deleting by object is conditional on the row existing
+		budget := t.timeouts.lwt
+		return doWithBudget(ctx, t.startup, t.Name+"/DeleteByObject", budget, func(retryCtx context.Context) error {
+			st := time.Now()
 
-		queryString := q.String()
-		t.Logger.Debug("delete using struct binding", zap.String("query", queryString))
+			q := t.Table.
+				DeleteBuilder().
+				Existing().
+				QueryContext(retryCtx, t.Session).
+				Consistency(t.writeConsistency).
+				BindStruct(instance)
 
-		defer q.Release()
+			queryString := q.String()
+			t.Logger.Debug("delete using struct binding", zap.String("query", queryString))
 
-		var timeRemaining AsStringerFunc = func() string {
-			return time.Since(st).String()
-		}
+			defer q.Release()
 
-		logger := t.Logger.
-			With(
-				zap.String("operation", "delete"),
-				zap.String("query", queryString),
-				zap.Duration("timeout", t.queryTimeout),
-			).
-			WithLazy(
-				zap.Stringer("execution_time_to_now", timeRemaining),
-			)
+			var timeRemaining AsStringerFunc = func() string {
+				return time.Since(st).String()
+			}
 
-		return retryBeforeTimeout(logger, q, false)
+			logger := t.Logger.
+				With(
+					zap.String("operation", "delete"),
+					zap.String("query", queryString),
+					zap.Duration("timeout", budget),
+				).
+				WithLazy(
+					zap.Stringer("execution_time_to_now", timeRemaining),
+				)
+
+			_, err := retryBeforeTimeout(logger, t.faultyExecutable(retryCtx, OperationDelete, q), false)
+			return err
+		})
 	})
 }
 
@@ -78,28 +84,130 @@ func (t *tableManagerImpl[T]) DeleteUsingOptions(ctx context.Context, opts ...De
 	})
 }
 
+// DeleteAndReturn removes a single row by primary key, returning the row that was removed. The row is read
+// before it is deleted and passed to any pre-delete hooks. Returns nil if there is no row, or if
+// WithDeleteIfExists is used and the row was removed by someone else after it was read. Returns
+// ErrPreconditionFailed if a WithDeleteIf condition doesn't hold.
+func (t *tableManagerImpl[T]) DeleteAndReturn(ctx context.Context, opts []DeleteOption, primaryKeys ...any) (*T, error) {
+	return returnWithTracing(ctx, t.Tracer, t.Name+"/DeleteAndReturn", t.TraceAttributes, t.DoTracing, func(ctx context.Context) (*T, error) {
+		if len(primaryKeys) != len(t.allKeyPredicates) {
+			return nil, fmt.Errorf("%w: expected %d primary key values, got %d", ErrKeyCount, len(t.allKeyPredicates), len(primaryKeys))
+		}
+
+		// Read directly rather than through the cache or a coalesced read, as we're returning what was removed
+		existing, err := returnWithBudget(ctx, t.startup, t.Name+"/DeleteAndReturn", t.timeouts.read, func(ctx context.Context) (*T, error) {
+			stmt, params := t.basicQueryBuilder().Where(t.allKeyPredicates...).ToCql()
+			return t.getRecord(ctx, t.Session.Query(stmt, params).WithContext(ctx).Consistency(t.readConsistency).Bind(primaryKeys...))
+		})
+		if errors.Is(err, gocql.ErrNotFound) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		defer t.invalidateCached(existing)
+
+		errHooks := t.runPreDeleteHooks(ctx, existing)
+		if errHooks != nil {
+			return nil, fmt.Errorf("running pre-delete hooks: %w", errHooks)
+		}
+
+		// The keys come first, so their bindings precede those of any IF conditions
+		opts = append([]DeleteOption{WithDeletePredicates(t.allKeyPredicates...), WithDeletionBindings(primaryKeys...)}, opts...)
+		isLWT, bindings := deleteBindings(opts)
+
+		applied, err := t.execDelete(ctx, isLWT, bindings, opts...)
+		if err != nil {
+			return nil, err
+		}
+		if !applied {
+			// IF EXISTS only fails if the row has already gone, but other conditions fail on its values
+			for _, opt := range opts {
+				if opt.isPrecondition() && len(opt.conditions()) > 0 {
+					return nil, ErrPreconditionFailed
+				}
+			}
+			return nil, nil
+		}
+
+		return existing, nil
+	})
+}
+
+// DeletePartition removes every row of a partition. If there are pre-delete hooks, the rows are first read
+// page by page and passed to the hooks, so hooks see each row removed. Rows written to the partition after
+// they have been read are removed without being passed to the hooks. Preconditions are only supported for
+// tables without clustering columns, as a partition of a clustered table may hold many rows; otherwise
+// ErrPartitionPrecondition is returned.
+func (t *tableManagerImpl[T]) DeletePartition(ctx context.Context, opts []DeleteOption, partitionKeys ...any) error {
+	defer t.cache.invalidateAll()
+
+	return doWithTracing(ctx, t.Tracer, t.Name+"/DeletePartition", t.TraceAttributes, t.DoTracing, func(ctx context.Context) error {
+		if len(partitionKeys) != len(t.partitionKeyPredicates) {
+			return fmt.Errorf("%w: expected %d partition key values, got %d", ErrKeyCount, len(t.partitionKeyPredicates), len(partitionKeys))
+		}
+		if len(t.TableMetadata.SortKey) > 0 {
+			for _, opt := range opts {
+				if opt.isPrecondition() {
+					return ErrPartitionPrecondition
+				}
+			}
+		}
+
+		// Pre-delete hooks
+		if len(t.preDeleteHooks) > 0 {
+			errSelect := t.SelectByPartitionKey(ctx, t.preDeletePage, nil, partitionKeys...)
+			if errSelect != nil {
+				return errSelect
+			}
+		}
+
+		// The keys come first, so their bindings precede those of any IF conditions
+		opts = append([]DeleteOption{WithDeletePredicates(t.partitionKeyPredicates...), WithDeletionBindings(partitionKeys...)}, opts...)
+		isLWT, bindings := deleteBindings(opts)
+
+		_, err := t.execDelete(ctx, isLWT, bindings, opts...)
+		return err
+	})
+}
+
+// preDeletePage is a page handler passing every row of a page to the pre-delete hooks, for deletes
+// that remove many rows
+func (t *tableManagerImpl[T]) preDeletePage(ctx context.Context, records []*T, originalPagingState []byte, newPagingState []byte) (bool, error) {
+	for _, record := range records {
+		errHooks := t.runPreDeleteHooks(ctx, record)
+		if errHooks != nil {
+			return false, fmt.Errorf("running pre-delete hooks: %w", errHooks)
+		}
+	}
+	return true, nil
+}
+
 // Truncate the table, leaving it with no rows
 func (t *tableManagerImpl[T]) Truncate(ctx context.Context) error {
+	defer t.cache.invalidateAll()
 	return doWithTracing(ctx, t.Tracer, t.Name+"/Truncate", t.TraceAttributes, t.DoTracing, func(ctx context.Context) error {
-		query := t.Session.
-			Query("TRUNCATE "+t.qualifiedTableName, nil).
-			WithContext(ctx).
-			Consistency(t.writeConsistency)
-		defer query.Release()
-		t.Logger.Debug("truncate", zap.String("operation", "truncate"), zap.String("query", query.String()))
-		return query.Exec()
+		return doWithBudget(ctx, t.startup, t.Name+"/Truncate", t.timeouts.write, func(ctx context.Context) error {
+			query := t.Session.
+				Query("TRUNCATE "+t.qualifiedTableName, nil).
+				WithContext(ctx).
+				Consistency(t.writeConsistency)
+			defer query.Release()
+			t.Logger.Debug("truncate", zap.String("operation", "truncate"), zap.String("query", query.String()))
+			return t.faultyExec(ctx, OperationTruncate, query.Exec)()
+		})
 	})
 }
 
 func (t *tableManagerImpl[T]) deleteInternal(ctx context.Context, opts ...DeleteOption) error {
-	var bindings []any
-	var isLWT bool
-	var predicates []qb.Cmp
+	// Deletes by predicates can't tell us which rows they remove
+	defer t.cache.invalidateAll()
+
+	isLWT, bindings := deleteBindings(opts)
 
+	var predicates []qb.Cmp
 	for _, opt := range opts {
-		isLWT = isLWT || opt.isPrecondition()
 		predicates = append(predicates, opt.conditions()...)
-		bindings = append(bindings, opt.bindings()...)
 	}
 
 	// Pre-delete hooks
@@ -114,53 +222,120 @@ func (t *tableManagerImpl[T]) deleteInternal(ctx context.Context, opts ...Delete
 		}
 	}
 
-	st := time.Now()
-	retryCtx, cancel := context.WithTimeout(ctx, t.queryTimeout)
-	defer cancel()
+	_, err := t.execDelete(ctx, isLWT, bindings, opts...)
+	return err
+}
+
+// execDelete runs a delete built from options, reporting whether it was applied. Deletes without
+// preconditions are always applied.
+func (t *tableManagerImpl[T]) execDelete(ctx context.Context, isLWT bool, bindings []any, opts ...DeleteOption) (bool, error) {
+	budget := budgetFor(t.timeouts.forWrite(isLWT), opts)
+	return returnWithBudget(ctx, t.startup, t.Name+"/Delete", budget, func(retryCtx context.Context) (bool, error) {
+		st := time.Now()
 
-	builder := qb.Delete(t.qualifiedTableName)
+		builder := qb.Delete(t.qualifiedTableName)
 
-	for _, b := range opts {
-		builder = b.applyToBuilder(builder)
-	}
+		for _, b := range opts {
+			builder = b.applyToDeleteBuilder(builder)
+		}
+
+		stmt, names := builder.ToCql()
+		query := t.Session.
+			Query(stmt, names).
+			WithContext(retryCtx).
+			Consistency(t.writeConsistency)
+
+		for _, mut := range opts {
+			query = mut.applyToQuery(query)
+		}
+		query = query.Bind(bindings...)
+
+		queryString := query.String()
+		t.Logger.Debug("delete using options", zap.String("query", queryString))
+
+		defer query.Release()
+
+		var timeRemaining AsStringerFunc = func() string {
+			return time.Since(st).String()
+		}
+
+		logger := t.Logger.
+			With(
+				zap.String("operation", "delete"),
+				zap.String("query", queryString),
+				zap.Duration("timeout", budget),
+			).
+			WithLazy(
+				zap.Stringer("execution_time_to_now", timeRemaining),
+			)
+
+		if isLWT {
+			if expect, ok := t.deleteExpectation(names, bindings, opts); ok {
+				return t.lwtResolver(gocql.Any).exec(ctx, "delete", t.faultyExecCAS(retryCtx, OperationDelete, query.ExecCAS), expect)
+			}
+		}
 
-	query := t.Session.
-		Query(builder.ToCql()).
-		WithContext(retryCtx).
-		Consistency(t.writeConsistency)
+		return retryBeforeTimeout(logger, t.faultyExecutable(retryCtx, OperationDelete, query), isLWT)
+	})
+}
 
-	for _, mut := range opts {
-		query = mut.applyToQuery(query)
+// deleteExpectation describes a conditional delete of a single row, so that a timed out delete can be
+// resolved by reading the row back. Deletes that don't bind every primary key column, or that have
+// conditions other than equality checks, can't be resolved this way.
+func (t *tableManagerImpl[T]) deleteExpectation(names []string, bindings []any, opts []DeleteOption) (lwtExpectation, bool) {
+	if len(names) != len(bindings) {
+		return lwtExpectation{}, false
 	}
-	query = query.Bind(bindings...)
 
-	queryString := query.String()
-	t.Logger.Debug("delete using options", zap.String("query", queryString))
+	bound := make(map[string]any, len(names))
+	for i, name := range names {
+		bound[name] = bindings[i]
+	}
 
-	defer query.Release()
+	expect := lwtExpectation{
+		expected: map[string]any{},
+		deletes:  true,
+	}
+	for _, col := range slices.Concat(t.TableMetadata.PartKey, t.TableMetadata.SortKey) {
+		val, ok := bound[col]
+		if !ok {
+			return lwtExpectation{}, false
+		}
+		expect.keys = append(expect.keys, val)
+	}
 
-	var timeRemaining AsStringerFunc = func() string {
-		return time.Since(st).String()
+	for _, opt := range opts {
+		if !opt.isPrecondition() {
+			continue
+		}
+		values := opt.expectedValues()
+		if values == nil {
+			return lwtExpectation{}, false
+		}
+		maps.Copy(expect.expected, values)
 	}
 
-	logger := t.Logger.
-		With(
-			zap.String("operation", "delete"),
-			zap.String("query", queryString),
-			zap.Duration("timeout", t.queryTimeout),
-		).
-		WithLazy(
-			zap.Stringer("execution_time_to_now", timeRemaining),
-		)
+	return expect, true
+}
 
-	return retryBeforeTimeout(logger, query, isLWT)
+// deleteBindings collects the bindings of a set of delete options, and whether any makes the delete an LWT
+func deleteBindings(opts []DeleteOption) (bool, []any) {
+	var bindings []any
+	var isLWT bool
+	for _, opt := range opts {
+		isLWT = isLWT || opt.isPrecondition()
+		bindings = append(bindings, opt.bindings()...)
+	}
+	return isLWT, bindings
 }
 
-func retryBeforeTimeout[E Executable](logger *zap.Logger, query E, isLWT bool) error {
+// retryBeforeTimeout executes a query, retrying write timeouts until the context ends, and reports whether
+// it was applied. Queries that aren't LWTs are always applied.
+func retryBeforeTimeout[E Executable](logger *zap.Logger, query E, isLWT bool) (bool, error) {
+	applied := true
 	for {
 		var err error
 		if isLWT {
-			var applied bool
 			applied, err = query.ExecCAS()
 			if !applied {
 				logger.Debug("no rows affected")
@@ -176,7 +351,13 @@ func retryBeforeTimeout[E Executable](logger *zap.Logger, query E, isLWT bool) e
 		var wto *gocql.RequestErrWriteTimeout
 		if !errors.As(err, &wto) {
 			logger.Debug("failure not retryable", zap.Error(err))
-			return err
+			return false, err
+		}
+
+		// A timed out LWT may have been applied, so retrying could report the wrong result
+		if isLWT {
+			logger.Debug("lwt outcome unknown", zap.Error(err))
+			return false, fmt.Errorf("%w: %w", ErrOutcomeUnknown, err)
 		}
 
 		logger.Info("retrying before timeout",
@@ -187,5 +368,5 @@ func retryBeforeTimeout[E Executable](logger *zap.Logger, query E, isLWT bool) e
 		)
 	}
 
-	return nil
+	return applied, nil
 }
@@ -2,8 +2,11 @@ package tables_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
+	"github.com/scylladb/gocqlx/v3/qb"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zaptest"
 
@@ -125,3 +128,177 @@ func TestDeleteUsingOptions(t *testing.T) {
 	require.NoError(t, errGet, "Should not error fetching")
 	require.Nil(t, fetched, "Should yield no result after delete")
 }
+
+// TestDeleteRange checks we can remove a window of clustering keys within a partition
+func TestDeleteRange(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+	manager, err := tables.NewTableManager[OrderItem](ctx,
+		tables.WithCluster(testClusterConfig),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(OrderItemsTableSpec))
+	require.NoError(t, err, "Should not error starting up")
+
+	// Arrange
+	for item := range 5 {
+		errInsert := manager.Insert(ctx, &OrderItem{
+			OrderID:  "delete-range-order-1",
+			ItemID:   fmt.Sprintf("delete-range-item-%d", item),
+			Quantity: item,
+		})
+		require.NoError(t, errInsert, "Should not error inserting")
+	}
+
+	// Act
+	errDelete := manager.DeleteRange(ctx, []any{"delete-range-order-1"}, tables.Range{
+		From: []any{"delete-range-item-1"},
+		To:   []any{"delete-range-item-3"},
+	})
+
+	// Assert
+	require.NoError(t, errDelete, "Should not error deleting")
+	for item, expectPresent := range []bool{true, true, false, true, true} {
+		fetched, errGet := manager.GetByPrimaryKey(ctx, "delete-range-order-1", fmt.Sprintf("delete-range-item-%d", item))
+		require.NoError(t, errGet, "Should not error fetching")
+		require.Equal(t, expectPresent, fetched != nil, "Only the exclusive range should be deleted")
+	}
+}
+
+// TestDeleteRangeHooks checks every row in a range is passed to pre-delete hooks
+func TestDeleteRangeHooks(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+	manager, err := tables.NewTableManager[OrderItem](ctx,
+		tables.WithCluster(testClusterConfig),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(OrderItemsTableSpec))
+	require.NoError(t, err, "Should not error starting up")
+
+	// Arrange
+	for item := range 5 {
+		errInsert := manager.Insert(ctx, &OrderItem{
+			OrderID:  "delete-range-order-2",
+			ItemID:   fmt.Sprintf("delete-range-item-%d", item),
+			Quantity: item,
+		})
+		require.NoError(t, errInsert, "Should not error inserting")
+	}
+	var hooked []string
+	manager.AddPreDeleteHook(func(ctx context.Context, record *OrderItem) error {
+		hooked = append(hooked, record.ItemID)
+		return nil
+	})
+
+	// Act
+	errDelete := manager.DeleteRange(ctx, []any{"delete-range-order-2"}, tables.Range{
+		From:          []any{"delete-range-item-1"},
+		To:            []any{"delete-range-item-3"},
+		FromInclusive: true,
+		ToInclusive:   true,
+	})
+	count, errCount := manager.CountByPartitionKey(ctx, "delete-range-order-2")
+
+	// Assert
+	require.NoError(t, errDelete, "Should not error deleting")
+	require.Equal(t, []string{"delete-range-item-1", "delete-range-item-2", "delete-range-item-3"}, hooked, "Should pass every row in the range to the hooks")
+	require.NoError(t, errCount, "Should not error counting")
+	require.EqualValues(t, 2, count, "Should remove the whole range")
+}
+
+// TestDeleteAndReturn checks deleting a row returns what was removed
+func TestDeleteAndReturn(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+	manager, err := tables.NewTableManager[OrderItem](ctx,
+		tables.WithCluster(testClusterConfig),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(OrderItemsTableSpec))
+	require.NoError(t, err, "Should not error starting up")
+
+	// Arrange
+	errInsert := manager.Insert(ctx, &OrderItem{OrderID: "delete-return-1", ItemID: "item-1", Quantity: 4})
+	require.NoError(t, errInsert, "Should not error inserting")
+
+	// Act
+	failed, errFailed := manager.DeleteAndReturn(ctx, []tables.DeleteOption{tables.WithDeleteIf(qb.Eq("quantity"), 5)}, "delete-return-1", "item-1")
+	removed, errDelete := manager.DeleteAndReturn(ctx, []tables.DeleteOption{tables.WithDeleteIfExists()}, "delete-return-1", "item-1")
+	again, errAgain := manager.DeleteAndReturn(ctx, nil, "delete-return-1", "item-1")
+	_, errKeys := manager.DeleteAndReturn(ctx, nil, "delete-return-1")
+
+	// Assert
+	require.ErrorIs(t, errFailed, tables.ErrPreconditionFailed, "Should report a condition that doesn't hold")
+	require.Nil(t, failed, "Should not return a row that wasn't removed")
+	require.NoError(t, errDelete, "Should not error deleting")
+	require.Equal(t, 4, removed.Quantity, "Should return the removed row")
+	require.NoError(t, errAgain, "Should not error deleting a missing row")
+	require.Nil(t, again, "Should not find the removed row")
+	require.ErrorIs(t, errKeys, tables.ErrKeyCount, "Should require the full primary key")
+}
+
+// TestDeletePartition checks a partition is removed, with each row passed to pre-delete hooks
+func TestDeletePartition(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+	manager, err := tables.NewTableManager[OrderItem](ctx,
+		tables.WithCluster(testClusterConfig),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(OrderItemsTableSpec))
+	require.NoError(t, err, "Should not error starting up")
+
+	// Arrange
+	for i := range 25 {
+		errInsert := manager.Insert(ctx, &OrderItem{OrderID: "delete-partition-1", ItemID: fmt.Sprintf("item-%02d", i), Quantity: i})
+		require.NoError(t, errInsert, "Should not error inserting")
+	}
+	var hooked []string
+	manager.AddPreDeleteHook(func(ctx context.Context, record *OrderItem) error {
+		hooked = append(hooked, record.ItemID)
+		return nil
+	})
+
+	// Act
+	errDelete := manager.DeletePartition(ctx, []tables.DeleteOption{tables.WithDeleteUsingTimestamp(time.Now().Add(time.Second).UnixMilli())}, "delete-partition-1")
+	count, errCount := manager.CountByPartitionKey(ctx, "delete-partition-1")
+
+	// Assert
+	require.NoError(t, errDelete, "Should not error deleting")
+	require.Len(t, hooked, 25, "Should pass every row to the hooks")
+	require.NoError(t, errCount, "Should not error counting")
+	require.Zero(t, count, "Should remove every row")
+}
+
+// TestDeletePartitionPreconditions checks preconditions are only accepted for tables without clustering columns
+func TestDeletePartitionPreconditions(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+	orders, err := tables.NewTableManager[Order](ctx,
+		tables.WithCluster(testClusterConfig),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(OrdersTableSpec))
+	require.NoError(t, err, "Should not error starting up orders")
+	items, err := tables.NewTableManager[OrderItem](ctx,
+		tables.WithCluster(testClusterConfig),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(OrderItemsTableSpec))
+	require.NoError(t, err, "Should not error starting up items")
+
+	// Arrange
+	errInsertOrder := orders.Insert(ctx, &Order{OrderID: "delete-partition-if-1"})
+	require.NoError(t, errInsertOrder, "Should not error inserting an order")
+	errInsertItem := items.Insert(ctx, &OrderItem{OrderID: "delete-partition-if-1", ItemID: "item-1", Quantity: 1})
+	require.NoError(t, errInsertItem, "Should not error inserting an item")
+
+	// Act
+	errOrders := orders.DeletePartition(ctx, []tables.DeleteOption{tables.WithDeleteIfExists()}, "delete-partition-if-1")
+	order, errGetOrder := orders.GetByPartitionKey(ctx, "delete-partition-if-1")
+	errItems := items.DeletePartition(ctx, []tables.DeleteOption{tables.WithDeleteIfExists()}, "delete-partition-if-1")
+	count, errCount := items.CountByPartitionKey(ctx, "delete-partition-if-1")
+
+	// Assert
+	require.NoError(t, errOrders, "Should accept a precondition without clustering columns")
+	require.NoError(t, errGetOrder, "Should not error fetching the order")
+	require.Nil(t, order, "Should remove the order")
+	require.ErrorIs(t, errItems, tables.ErrPartitionPrecondition, "Should reject a precondition with clustering columns")
+	require.NoError(t, errCount, "Should not error counting")
+	require.EqualValues(t, 1, count, "Should leave the partition in place")
+}
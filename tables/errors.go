@@ -4,3 +4,86 @@ import "errors"
 
 // ErrPreconditionFailed indicates an IF predicate on an LWT was not satisfied
 var ErrPreconditionFailed = errors.New("precondition failed for LWT operation")
+
+// ErrNoStaticColumns indicates a partition-level operation was requested on a table with no static columns
+var ErrNoStaticColumns = errors.New("table has no static columns")
+
+// ErrInvalidTTLField indicates a field tagged as providing a row TTL is not a time.Duration or time.Time
+var ErrInvalidTTLField = errors.New("row TTL fields must be a time.Duration or time.Time")
+
+// ErrMultipleTTLFields indicates more than one field on a record is tagged as providing a row TTL
+var ErrMultipleTTLFields = errors.New("only one field may provide a row TTL")
+
+// ErrKeyChanged indicates a read-modify-write function changed the key of the record it was given
+var ErrKeyChanged = errors.New("update function changed the record key")
+
+// ErrKeyCount indicates the wrong number of key values were supplied for an operation
+var ErrKeyCount = errors.New("incorrect number of key values")
+
+// ErrUnknownColumn indicates a column was referenced that is not suitable for, or not part of, the table
+var ErrUnknownColumn = errors.New("unknown or unsuitable column")
+
+// ErrInvalidRecord indicates a record supplied to an operation was missing or unusable
+var ErrInvalidRecord = errors.New("invalid or missing record")
+
+// ErrInvalidRange indicates a clustering key range could not be applied to the table
+var ErrInvalidRange = errors.New("invalid clustering key range")
+
+// ErrBatchPrecondition indicates an option adding an LWT precondition was used with a batched write
+var ErrBatchPrecondition = errors.New("preconditions are not supported for batched writes")
+
+// ErrPartitionPrecondition indicates an option adding an LWT precondition was used to delete a partition of a
+// table with clustering columns, which Scylla only allows conditions on single rows of
+var ErrPartitionPrecondition = errors.New("preconditions are not supported for partition deletes of clustered tables")
+
+// ErrWriterClosed indicates a write was attempted on a BulkWriter that has been closed
+var ErrWriterClosed = errors.New("bulk writer is closed")
+
+// ErrBulkWriteFailed indicates some rows queued on a BulkWriter could not be written
+var ErrBulkWriteFailed = errors.New("bulk writer failed to write some rows")
+
+// ErrOutcomeUnknown indicates an LWT timed out and reading the row back could not show whether it was applied
+var ErrOutcomeUnknown = errors.New("outcome of LWT operation is unknown")
+
+// ErrTimeout indicates an operation ran out of time. Timeouts are reported as a *TimeoutError, which
+// matches ErrTimeout with errors.Is.
+var ErrTimeout = errors.New("operation timed out")
+
+// ErrNotReady indicates a manager using lazy startup could not be used, either because startup failed
+// or because the context ended before it completed
+var ErrNotReady = errors.New("manager is not ready")
+
+// ErrManagerClosed indicates an operation was attempted on a manager that has been closed
+var ErrManagerClosed = errors.New("manager has been closed")
+
+// ErrNoSession indicates a manager was created without any way of getting a session
+var ErrNoSession = errors.New("no session configured, use WithCluster, WithSession or WithSessionPool")
+
+// ErrSchemaMismatch indicates the live schema differs from the specification. Differences are reported
+// as a *SchemaMismatchError, which matches ErrSchemaMismatch with errors.Is.
+var ErrSchemaMismatch = errors.New("schema does not match specification")
+
+// ErrNoSchemaVerifier indicates Verify was called on a manager created without WithSchemaVerifier
+var ErrNoSchemaVerifier = errors.New("no schema verifier configured, use WithSchemaVerifier")
+
+// ErrUnknownQuery indicates Query was called with a name not declared on the table or view specification
+var ErrUnknownQuery = errors.New("unknown query")
+
+// ErrQueryParameters indicates the parameters given to Query don't match those the query declares
+var ErrQueryParameters = errors.New("query parameters do not match")
+
+// ErrUnsupportedManager indicates a helper such as SelectAs or UnitOfWork was given a manager not created
+// by this package, such as a mock
+var ErrUnsupportedManager = errors.New("manager was not created by this package")
+
+// ErrKeyType indicates key values, or the key type of a Repository, don't match the key columns of a table
+var ErrKeyType = errors.New("key type does not match table keys")
+
+// ErrSessionMismatch indicates a UnitOfWork was committed with writes from managers that don't share a session
+var ErrSessionMismatch = errors.New("managers do not share a session")
+
+// ErrUnitOfWorkCommitted indicates a write was added to, or a commit made of, a UnitOfWork already committed
+var ErrUnitOfWorkCommitted = errors.New("unit of work has already been committed")
+
+// ErrUnsupportedOption indicates an option was given to an operation it can't be applied to
+var ErrUnsupportedOption = errors.New("option is not supported by this operation")
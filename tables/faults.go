@@ -0,0 +1,119 @@
+package tables
+
+import (
+	"context"
+
+	"github.com/scylladb/gocqlx/v3"
+)
+
+// Kinds of statement reported to a FaultInjector
+const (
+	OperationRead     = "read"     // Reads, including counts and each page of a query
+	OperationInsert   = "insert"   // Inserts
+	OperationUpsert   = "upsert"   // Upserts, including static columns
+	OperationBatch    = "batch"    // Batches of upserts from bulk operations
+	OperationUpdate   = "update"   // Updates, including UpdateFunc and CompareAndSwap
+	OperationDelete   = "delete"   // Deletes
+	OperationTruncate = "truncate" // Truncating the table
+)
+
+// Statement describes a statement about to be sent, for a FaultInjector to decide whether to fail it
+type Statement struct {
+	Table       string // Table or view the statement is for
+	Operation   string // Kind of statement, one of the Operation constants
+	Conditional bool   // The statement is a lightweight transaction
+}
+
+// FaultInjector can fail or delay statements before they are sent, to test how errors that a real
+// cluster rarely produces are handled. Every attempt is passed through the injector, including those
+// made by retries. The tables/chaos package provides a configurable implementation.
+type FaultInjector interface {
+	// BeforeStatement is called before each statement is sent, and may block to add latency. Returning
+	// an error fails the statement without sending it. Returning notApplied makes a conditional statement
+	// report that its condition did not hold, and is ignored for other statements.
+	BeforeStatement(ctx context.Context, stmt Statement) (notApplied bool, err error)
+}
+
+// injectFault gives the fault injector, if there is one, the chance to fail or delay a statement
+func (t *baseManagerImpl[T]) injectFault(ctx context.Context, operation string, conditional bool) (bool, error) {
+	if t.faults == nil {
+		return false, nil
+	}
+
+	return t.faults.BeforeStatement(ctx, Statement{
+		Table:       t.Name,
+		Operation:   operation,
+		Conditional: conditional,
+	})
+}
+
+// faultyRead runs a read through the fault injector
+func (t *baseManagerImpl[T]) faultyRead(ctx context.Context) error {
+	_, err := t.injectFault(ctx, OperationRead, false)
+	return err
+}
+
+// faultyGet fetches a single row into dest, running the read through the fault injector first
+func (t *baseManagerImpl[T]) faultyGet(ctx context.Context, query *gocqlx.Queryx, dest any) error {
+	if err := t.faultyRead(ctx); err != nil {
+		return err
+	}
+	return query.Get(dest)
+}
+
+// faultyExec wraps executing a statement so that it runs through the fault injector first
+func (t *baseManagerImpl[T]) faultyExec(ctx context.Context, operation string, exec func() error) func() error {
+	if t.faults == nil {
+		return exec
+	}
+
+	return func() error {
+		if _, err := t.injectFault(ctx, operation, false); err != nil {
+			return err
+		}
+		return exec()
+	}
+}
+
+// faultyExecCAS wraps executing a conditional statement so that it runs through the fault injector first
+func (t *baseManagerImpl[T]) faultyExecCAS(ctx context.Context, operation string, execCAS func() (bool, error)) func() (bool, error) {
+	if t.faults == nil {
+		return execCAS
+	}
+
+	return func() (bool, error) {
+		notApplied, err := t.injectFault(ctx, operation, true)
+		if err != nil || notApplied {
+			return false, err
+		}
+		return execCAS()
+	}
+}
+
+// faultyExecutable wraps an Executable so that it runs through the fault injector first
+func (t *baseManagerImpl[T]) faultyExecutable(ctx context.Context, operation string, query Executable) Executable {
+	if t.faults == nil {
+		return query
+	}
+
+	return executableFuncs{
+		exec:    t.faultyExec(ctx, operation, query.Exec),
+		execCAS: t.faultyExecCAS(ctx, operation, query.ExecCAS),
+	}
+}
+
+// executableFuncs adapts a pair of functions to the Executable interface
+type executableFuncs struct {
+	exec    func() error
+	execCAS func() (bool, error)
+}
+
+// Exec implements Executable
+func (e executableFuncs) Exec() error {
+	return e.exec()
+}
+
+// ExecCAS implements Executable
+func (e executableFuncs) ExecCAS() (bool, error) {
+	return e.execCAS()
+}
@@ -0,0 +1,230 @@
+package tables
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/scylladb/gocqlx/v3"
+	"github.com/scylladb/gocqlx/v3/qb"
+
+	"github.com/zeroflucs-given/charybdis/metadata"
+)
+
+// SchemaProblemKind is the kind of difference found between the live schema and a specification
+type SchemaProblemKind string
+
+const (
+	SchemaMissingObject SchemaProblemKind = "missing_object" // The table or view does not exist
+	SchemaMissingColumn SchemaProblemKind = "missing_column" // A column in the specification does not exist
+	SchemaTypeMismatch  SchemaProblemKind = "type_mismatch"  // A column exists, but with a different type
+	SchemaMissingIndex  SchemaProblemKind = "missing_index"  // An index in the specification does not exist
+)
+
+// SchemaProblem is a single difference between the live schema and a specification
+type SchemaProblem struct {
+	Kind     SchemaProblemKind `json:"kind"`               // Kind of problem
+	Name     string            `json:"name"`               // Name of the table, view, column or index
+	Expected string            `json:"expected,omitempty"` // Expected CQL type, for type mismatches
+	Actual   string            `json:"actual,omitempty"`   // Actual CQL type, for type mismatches
+}
+
+// String describes the problem
+func (p SchemaProblem) String() string {
+	if p.Kind == SchemaTypeMismatch {
+		return fmt.Sprintf("%s %q: expected %s, got %s", p.Kind, p.Name, p.Expected, p.Actual)
+	}
+	return fmt.Sprintf("%s %q", p.Kind, p.Name)
+}
+
+// SchemaMismatchError describes how the live schema of a table or view differs from its specification
+type SchemaMismatchError struct {
+	Object   string          // Qualified name of the table or view
+	Problems []SchemaProblem // Differences found
+}
+
+// Error implements [error]
+func (e *SchemaMismatchError) Error() string {
+	problems := make([]string, len(e.Problems))
+	for i, p := range e.Problems {
+		problems[i] = p.String()
+	}
+	return fmt.Sprintf("schema of %s does not match specification: %s", e.Object, strings.Join(problems, "; "))
+}
+
+// Is allows errors.Is to match ErrSchemaMismatch
+func (e *SchemaMismatchError) Is(target error) bool {
+	return target == ErrSchemaMismatch
+}
+
+// SchemaVerifier compares the live schema with table and view specifications. The generator package
+// provides an implementation with generator.WithSchemaVerification.
+type SchemaVerifier interface {
+	// VerifyTable reports how a table differs from its specification
+	VerifyTable(ctx context.Context, sess gocqlx.Session, keyspace string, spec *metadata.TableSpecification) ([]SchemaProblem, error)
+
+	// VerifyView reports how a view differs from its specification
+	VerifyView(ctx context.Context, sess gocqlx.Session, keyspace string, spec *metadata.ViewSpecification) ([]SchemaProblem, error)
+}
+
+// Ping runs a cheap query against the table at the manager's read consistency, to check enough
+// replicas are reachable to serve reads
+func (t *baseManagerImpl[T]) Ping(ctx context.Context) error {
+	return doWithTracing(ctx, t.Tracer, t.Name+"/Ping", t.TraceAttributes, t.DoTracing, func(ctx context.Context) error {
+		return doWithBudget(ctx, t.startup, t.Name+"/Ping", t.timeouts.read, func(ctx context.Context) error {
+			stmt, params := qb.
+				Select(t.Table.Name()).
+				Columns(t.TableMetadata.PartKey[0]).
+				Limit(1).
+				ToCql()
+
+			return t.Session.ContextQuery(ctx, stmt, params).
+				Consistency(t.readConsistency).
+				Iter().
+				Close()
+		})
+	})
+}
+
+// Verify compares the live schema with the manager's specification, returning a *SchemaMismatchError
+// listing any differences. Requires a SchemaVerifier, set with WithSchemaVerifier.
+func (t *baseManagerImpl[T]) Verify(ctx context.Context) error {
+	return doWithTracing(ctx, t.Tracer, t.Name+"/Verify", t.TraceAttributes, t.DoTracing, func(ctx context.Context) error {
+		if t.verifySchema == nil {
+			return ErrNoSchemaVerifier
+		}
+
+		return doWithBudget(ctx, t.startup, t.Name+"/Verify", t.timeouts.read, func(ctx context.Context) error {
+			problems, err := t.verifySchema(ctx, t.Session)
+			if err != nil {
+				return fmt.Errorf("error verifying schema: %w", err)
+			}
+			if len(problems) > 0 {
+				return &SchemaMismatchError{
+					Object:   t.qualifiedTableName,
+					Problems: problems,
+				}
+			}
+			return nil
+		})
+	})
+}
+
+// HealthCheckable is anything that can be checked by a HealthRegistry, such as a TableManager or ViewManager
+type HealthCheckable interface {
+	Ping(ctx context.Context) error
+	Verify(ctx context.Context) error
+}
+
+// HealthRegistry runs health checks across a set of registered managers
+type HealthRegistry struct {
+	mu      sync.Mutex
+	names   []string
+	members map[string]HealthCheckable
+}
+
+// HealthReport is the result of checking every manager in a HealthRegistry
+type HealthReport struct {
+	Healthy   bool            `json:"healthy"`    // Every check passed
+	CheckedAt time.Time       `json:"checked_at"` // When the checks started
+	Managers  []ManagerHealth `json:"managers"`   // Results for each manager, in order of registration
+}
+
+// ManagerHealth is the result of checking a single manager
+type ManagerHealth struct {
+	Name           string          `json:"name"`                      // Name the manager was registered with
+	Healthy        bool            `json:"healthy"`                   // Every check passed
+	PingLatency    time.Duration   `json:"ping_latency"`              // How long the ping took
+	PingError      string          `json:"ping_error,omitempty"`      // Why the ping failed
+	Verified       bool            `json:"verified"`                  // Whether the schema was verified
+	VerifyError    string          `json:"verify_error,omitempty"`    // Why verification failed, other than differences
+	SchemaProblems []SchemaProblem `json:"schema_problems,omitempty"` // Differences from the specification
+}
+
+// NewHealthRegistry creates an empty health registry
+func NewHealthRegistry() *HealthRegistry {
+	return &HealthRegistry{
+		members: map[string]HealthCheckable{},
+	}
+}
+
+// Register adds a manager to the registry under a name, replacing any manager already registered with it
+func (r *HealthRegistry) Register(name string, manager HealthCheckable) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.members[name]; !ok {
+		r.names = append(r.names, name)
+	}
+	r.members[name] = manager
+}
+
+// Check pings every registered manager concurrently and, if verifySchema is set, verifies their schema.
+// Pings suit liveness and readiness probes, whereas verifying the schema reads cluster metadata so is
+// better suited to checks made at startup or on demand.
+func (r *HealthRegistry) Check(ctx context.Context, verifySchema bool) *HealthReport {
+	r.mu.Lock()
+	names := append([]string(nil), r.names...)
+	members := make([]HealthCheckable, len(names))
+	for i, name := range names {
+		members[i] = r.members[name]
+	}
+	r.mu.Unlock()
+
+	report := &HealthReport{
+		Healthy:   true,
+		CheckedAt: time.Now(),
+		Managers:  make([]ManagerHealth, len(names)),
+	}
+
+	var wg sync.WaitGroup
+	for i := range names {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			report.Managers[i] = checkManager(ctx, names[i], members[i], verifySchema)
+		}()
+	}
+	wg.Wait()
+
+	for _, m := range report.Managers {
+		report.Healthy = report.Healthy && m.Healthy
+	}
+
+	return report
+}
+
+// checkManager runs the checks for a single manager
+func checkManager(ctx context.Context, name string, manager HealthCheckable, verifySchema bool) ManagerHealth {
+	result := ManagerHealth{
+		Name:    name,
+		Healthy: true,
+	}
+
+	start := time.Now()
+	if err := manager.Ping(ctx); err != nil {
+		result.Healthy = false
+		result.PingError = err.Error()
+	}
+	result.PingLatency = time.Since(start)
+
+	if !verifySchema {
+		return result
+	}
+
+	result.Verified = true
+	if err := manager.Verify(ctx); err != nil {
+		result.Healthy = false
+		var mismatch *SchemaMismatchError
+		if errors.As(err, &mismatch) {
+			result.SchemaProblems = mismatch.Problems
+		} else {
+			result.VerifyError = err.Error()
+		}
+	}
+
+	return result
+}
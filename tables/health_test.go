@@ -0,0 +1,64 @@
+package tables_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/zeroflucs-given/charybdis/generator"
+	"github.com/zeroflucs-given/charybdis/metadata"
+	"github.com/zeroflucs-given/charybdis/tables"
+)
+
+// TestHealthRegistry checks the registry pings managers and reports schema differences
+func TestHealthRegistry(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+	logger := zaptest.NewLogger(t)
+
+	orders, err := tables.NewTableManager[Order](ctx,
+		tables.WithCluster(testClusterConfig),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(OrdersTableSpec),
+		generator.WithAutomaticTableManagement(logger, testClusterConfig),
+		generator.WithSchemaVerification())
+	require.NoError(t, err, "Should not error starting up")
+	defer orders.Close()
+
+	// Arrange
+	driftedSpec := OrdersTableSpec.Clone(false)
+	driftedSpec.Columns = append(driftedSpec.Columns, &metadata.ColumnSpecification{
+		Name:    "not_yet_added",
+		CQLType: "text",
+	})
+	drifted, err := tables.NewTableManager[Order](ctx,
+		tables.WithCluster(testClusterConfig),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(driftedSpec),
+		generator.WithSchemaVerification())
+	require.NoError(t, err, "Should not error starting up")
+	defer drifted.Close()
+
+	registry := tables.NewHealthRegistry()
+	registry.Register("orders", orders)
+	registry.Register("drifted", drifted)
+
+	// Act
+	errPing := orders.Ping(ctx)
+	errVerify := drifted.Verify(ctx)
+	report := registry.Check(ctx, true)
+
+	// Assert
+	require.NoError(t, errPing, "Should be able to ping")
+	require.ErrorIs(t, errVerify, tables.ErrSchemaMismatch, "Should report the schema differs")
+	require.False(t, report.Healthy, "Report should be unhealthy")
+	require.Len(t, report.Managers, 2, "Should report on each manager")
+	require.True(t, report.Managers[0].Healthy, "Orders should be healthy")
+	require.False(t, report.Managers[1].Healthy, "Drifted table should not be healthy")
+	require.Empty(t, report.Managers[1].PingError, "Drifted table should still ping")
+	require.Equal(t, []tables.SchemaProblem{
+		{Kind: tables.SchemaMissingColumn, Name: "not_yet_added"},
+	}, report.Managers[1].SchemaProblems, "Should report the missing column")
+}
@@ -55,10 +55,10 @@ func (t *tableManagerImpl[T]) runPreDeleteHooks(ctx context.Context, instance *T
 		return nil
 	}
 
-	for i, hook := range t.preHooks {
+	for i, hook := range t.preDeleteHooks {
 		err := hook(ctx, instance)
 		if err != nil {
-			return fmt.Errorf("error executing pre-hook at index %d: %w", i, err)
+			return fmt.Errorf("error executing pre-delete hook at index %d: %w", i, err)
 		}
 	}
 
@@ -3,19 +3,19 @@ package tables
 import (
 	"context"
 	"errors"
+	"slices"
 	"time"
 
 	"github.com/gocql/gocql"
 	"github.com/scylladb/gocqlx/v3/qb"
 	"go.uber.org/zap"
-	"golang.org/x/sync/errgroup"
 )
 
 // Insert inserts a single object. Unlike upsert it enforces the value does not exist. You can achieve
 // the same effect with an Upsert if you use the WithNotExist option.
 func (t *tableManagerImpl[T]) Insert(ctx context.Context, instance *T, opts ...InsertOption) error {
 	return doWithTracing(ctx, t.Tracer, t.Name+"/Insert", t.TraceAttributes, t.DoTracing, func(ctx context.Context) error {
-		return t.insertInternal(ctx, instance, true, opts...)
+		return t.insertInternal(ctx, instance, true, gocql.Any, opts...)
 	})
 }
 
@@ -23,40 +23,33 @@ func (t *tableManagerImpl[T]) Insert(ctx context.Context, instance *T, opts ...I
 // works for tables with no non-key columns.
 func (t *tableManagerImpl[T]) InsertOrReplace(ctx context.Context, instance *T, opts ...InsertOption) error {
 	return doWithTracing(ctx, t.Tracer, t.Name+"/Insert", t.TraceAttributes, t.DoTracing, func(ctx context.Context) error {
-		return t.insertInternal(ctx, instance, false, opts...)
+		return t.insertInternal(ctx, instance, false, gocql.Any, opts...)
 	})
 }
 
 // InsertBulk inserts many objects in parallel, up to a given number. If the concurrency limit is not set,
-// then a default of DefaultBulkConcurrency is used.
+// then a default of DefaultBulkConcurrency is used. If the concurrency is Adaptive, the manager's
+// AdaptiveLimiter decides how many run at once.
 func (t *tableManagerImpl[T]) InsertBulk(ctx context.Context, instances []*T, concurrency int, opts ...InsertOption) error {
-	if concurrency <= 0 {
-		concurrency = DefaultBulkConcurrency
-	}
-
 	return doWithTracing(ctx, t.Tracer, t.Name+"/InsertBulk", t.TraceAttributes, t.DoTracing, func(ctx context.Context) error {
-		grp, grpCtx := errgroup.WithContext(ctx)
-		grp.SetLimit(concurrency)
-
-		for _, v := range instances {
-			item := v
-			grp.Go(func() error {
-				return t.insertInternal(grpCtx, item, true, opts...)
-			})
-		}
-
-		return grp.Wait()
+		return runBulk(ctx, t.limiter, instances, concurrency, func(ctx context.Context, instance *T) error {
+			return t.insertInternal(ctx, instance, true, gocql.Any, opts...)
+		})
 	})
 }
 
-// insertInternal is a helper function that performs a single upsert
-func (t *tableManagerImpl[T]) insertInternal(ctx context.Context, instance *T, enforceNotExists bool, opts ...InsertOption) error {
-	// Pre-change hooks
-	err := t.runPreHooks(ctx, instance)
+// insertInternal is a helper function that performs a single insert. Non-serial consistency levels leave
+// the session's serial consistency in place for any LWT.
+func (t *tableManagerImpl[T]) insertInternal(ctx context.Context, instance *T, enforceNotExists bool, serialConsistency gocql.Consistency, opts ...InsertOption) error {
+	// Lifecycle methods and pre-change hooks
+	err := t.prepareInsert(ctx, instance)
 	if err != nil {
 		return err
 	}
 
+	// A failed write may still have been applied, so cached reads are invalidated whatever the outcome
+	defer t.invalidateCached(instance)
+
 	isLWT := false
 	if enforceNotExists {
 		// We must not exist
@@ -66,68 +59,88 @@ func (t *tableManagerImpl[T]) insertInternal(ctx context.Context, instance *T, e
 
 	// Build our query
 	query := qb.Insert(t.qualifiedTableName).Columns(t.allColumnNames...)
+	if t.rowTTL != nil {
+		query = query.TTLNamed(rowTTLBindingName)
+	}
 
+	skipNil := false
 	for _, opt := range opts {
 		query = opt.applyToInsertBuilder(query)
 		isLWT = isLWT || opt.isPrecondition()
+		skipNil = skipNil || opt.skipsNilColumns()
 	}
 
-	st := time.Now()
-	retryCtx, cancel := context.WithTimeout(ctx, t.queryTimeout)
-	defer cancel()
+	budget := budgetFor(t.timeouts.forWrite(isLWT), opts)
+	return doWithBudget(ctx, t.startup, t.Name+"/Insert", budget, func(retryCtx context.Context) error {
+		st := time.Now()
 
-	stmt, params := query.ToCql()
+		stmt, params := query.ToCql()
 
-	var applied bool
-	q := t.Session.ContextQuery(retryCtx, stmt, params).
-		Consistency(t.writeConsistency).
-		BindStruct(instance)
+		var applied bool
+		q := t.Session.ContextQuery(retryCtx, stmt, params).
+			Consistency(t.writeConsistency).
+			WithBindTransformer(t.unsetTransformer(skipNil)).
+			BindStructMap(instance, t.rowTTLBindings(instance))
+		if serialConsistency.IsSerial() {
+			q.SerialConsistency(serialConsistency)
+		}
 
-	queryString := q.String()
+		queryString := q.String()
 
-	t.Logger.Debug("insert", zap.String("query", queryString))
-	defer q.Release()
+		t.Logger.Debug("insert", zap.String("query", queryString))
+		defer q.Release()
 
-	for {
 		if isLWT {
-			applied, err = q.ExecCAS()
+			keyColumns := slices.Concat(t.TableMetadata.PartKey, t.TableMetadata.SortKey)
+			applied, err = t.lwtResolver(serialConsistency).exec(ctx, "insert", t.faultyExecCAS(retryCtx, OperationInsert, q.ExecCAS), lwtExpectation{
+				keys:      t.columnValues(instance, keyColumns),
+				written:   t.writtenValues(instance, t.allColumnNames, skipNil),
+				notExists: true,
+			})
+			if err != nil {
+				t.Logger.Debug("failure not retryable", zap.Error(err))
+				return err
+			}
 			if !applied {
 				t.Logger.Debug("inserted no rows", zap.String("query", queryString))
 			}
 		} else {
-			err = q.Exec()
+			exec := t.faultyExec(retryCtx, OperationInsert, q.Exec)
+			for {
+				err = exec()
+				if err == nil {
+					break
+				}
+
+				var wto *gocql.RequestErrWriteTimeout
+				retryable := errors.As(err, &wto)
+				if !retryable {
+					t.Logger.Debug("failure not retryable", zap.Error(err))
+					return err
+				}
+				reportAttempt(retryCtx, err)
+
+				t.Logger.Debug("insert retrying from early write timeout",
+					zap.String("consistency", wto.Consistency.String()),
+					zap.Int("received", wto.Received),
+					zap.Int("blockFor", wto.BlockFor),
+					zap.String("writeType", wto.WriteType),
+					zap.Duration("set_timeout", budget),
+					zap.Duration("execution_time_to_now", time.Since(st)),
+				)
+			}
 		}
 
-		if err == nil {
-			break
+		if isLWT && !applied {
+			return ErrPreconditionFailed
 		}
 
-		var wto *gocql.RequestErrWriteTimeout
-		retryable := errors.As(err, &wto)
-		if !retryable {
-			t.Logger.Debug("failure not retryable", zap.Error(err))
-			return err
+		// Post-change hooks
+		errPost := t.runPostHooks(ctx, instance)
+		if errPost != nil {
+			return errPost
 		}
 
-		t.Logger.Debug("insert retrying from early write timeout",
-			zap.String("consistency", wto.Consistency.String()),
-			zap.Int("received", wto.Received),
-			zap.Int("blockFor", wto.BlockFor),
-			zap.String("writeType", wto.WriteType),
-			zap.Duration("set_timeout", t.queryTimeout),
-			zap.Duration("execution_time_to_now", time.Since(st)),
-		)
-	}
-
-	if isLWT && !applied {
-		return ErrPreconditionFailed
-	}
-
-	// Post-change hooks
-	errPost := t.runPostHooks(ctx, instance)
-	if errPost != nil {
-		return errPost
-	}
-
-	return nil
+		return nil
+	})
 }
@@ -133,3 +133,118 @@ func TestInsertRecordWithTTL(t *testing.T) {
 	require.NoError(t, errGet, "Should not error fetching")
 	require.Nil(t, fetched, "Should get no object back")
 }
+
+// TestInsertRecordWithRowTTL checks a TTL taken from a record field is applied per row
+func TestInsertRecordWithRowTTL(t *testing.T) {
+	type ExpiringOrder struct {
+		OrderID         string        `cql:"order_id"`
+		ShippingAddress Address       `cql:"shipping_address"`
+		Expiry          time.Duration `cqlttl:"true"`
+	}
+
+	// Test globals
+	ctx := context.Background()
+	manager, err := tables.NewTableManager[ExpiringOrder](ctx,
+		tables.WithCluster(testClusterConfig),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(OrdersTableSpec))
+	require.NoError(t, err, "Should not error starting up")
+
+	// Arrange
+	orders := []*ExpiringOrder{
+		{
+			OrderID:         "insert-test-row-ttl-short",
+			ShippingAddress: testAddress(5, "Short Street", "Somerville"),
+			Expiry:          time.Second,
+		},
+		{
+			OrderID:         "insert-test-row-ttl-none",
+			ShippingAddress: testAddress(6, "Long Street", "Somerville"),
+		},
+	}
+
+	// Act
+	errInsert := manager.InsertBulk(ctx, orders, -1)
+	require.NoError(t, errInsert, "Should not error inserting")
+	time.Sleep(2 * time.Second)
+
+	// Assert
+	expired, errExpired := manager.GetByPartitionKey(ctx, "insert-test-row-ttl-short")
+	require.NoError(t, errExpired, "Should not error fetching")
+	require.Nil(t, expired, "Row with a short TTL should have expired")
+
+	kept, errKept := manager.GetByPartitionKey(ctx, "insert-test-row-ttl-none")
+	require.NoError(t, errKept, "Should not error fetching")
+	require.NotNil(t, kept, "Row with no TTL should remain")
+}
+
+// TestGetOrInsert checks the first call inserts and the second returns the existing record
+func TestGetOrInsert(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+	manager, err := tables.NewTableManager[Order](ctx,
+		tables.WithCluster(testClusterConfig),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(OrdersTableSpec))
+	require.NoError(t, err, "Should not error starting up")
+
+	// Arrange
+	first := &Order{
+		OrderID:         "insert-test-get-or-insert",
+		ShippingAddress: testAddress(4, "First Street", "Somerville"),
+	}
+	second := &Order{
+		OrderID:         "insert-test-get-or-insert",
+		ShippingAddress: testAddress(4, "Second Street", "Somerville"),
+	}
+
+	// Act
+	resultFirst, insertedFirst, errFirst := manager.GetOrInsert(ctx, first)
+	resultSecond, insertedSecond, errSecond := manager.GetOrInsert(ctx, second)
+
+	// Assert
+	require.NoError(t, errFirst, "Should not error on first call")
+	require.True(t, insertedFirst, "First call should insert")
+	require.Equal(t, first, resultFirst, "First call should return the inserted record")
+	require.NoError(t, errSecond, "Should not error on second call")
+	require.False(t, insertedSecond, "Second call should not insert")
+	require.NotNil(t, resultSecond, "Second call should return the existing record")
+	require.Equal(t, testAddress(4, "First Street", "Somerville"), resultSecond.ShippingAddress, "Should get the original state")
+}
+
+// TestInsertWithLifecycle checks the lifecycle methods of a record are called as it is written and read
+func TestInsertWithLifecycle(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+	manager, err := tables.NewTableManager[CheckedItem](ctx,
+		tables.WithCluster(testClusterConfig),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(OrderItemsTableSpec))
+	require.NoError(t, err, "Should not error starting up")
+
+	// Arrange
+	defaulted := &CheckedItem{OrderID: "insert-test-lifecycle", ItemID: "item-1"}
+	invalid := &CheckedItem{OrderID: "insert-test-lifecycle", ItemID: "item-2", Quantity: -1}
+
+	// Act
+	errInsert := manager.Insert(ctx, defaulted)
+	errInvalid := manager.Upsert(ctx, invalid)
+	fetched, errGet := manager.GetByPrimaryKey(ctx, "insert-test-lifecycle", "item-1")
+	var selected []*CheckedItem
+	errSelect := manager.SelectByPartitionKey(ctx, func(ctx context.Context, records []*CheckedItem, originalPagingState []byte, newPagingState []byte) (bool, error) {
+		selected = append(selected, records...)
+		return true, nil
+	}, nil, "insert-test-lifecycle")
+
+	// Assert
+	require.NoError(t, errInsert, "Should not error inserting")
+	require.Equal(t, 1, defaulted.Quantity, "Should call BeforeInsert before writing")
+	require.ErrorIs(t, errInvalid, tables.ErrInvalidRecord, "Should reject records failing validation")
+	require.NoError(t, errGet, "Should not error fetching")
+	require.NotNil(t, fetched, "Should get the record back")
+	require.Equal(t, 1, fetched.Quantity, "Should have written the defaulted quantity")
+	require.True(t, fetched.loaded, "Should call AfterLoad on fetched records")
+	require.NoError(t, errSelect, "Should not error selecting")
+	require.Len(t, selected, 1, "Should not have written the invalid record")
+	require.True(t, selected[0].loaded, "Should call AfterLoad on selected records")
+}
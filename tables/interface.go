@@ -2,6 +2,7 @@ package tables
 
 import (
 	"context"
+	"time"
 
 	"github.com/scylladb/gocqlx/v3"
 	"github.com/scylladb/gocqlx/v3/qb"
@@ -11,6 +12,13 @@ import (
 
 // TableManager is an object that provides an abstraction over a table in ScyllaDB
 type TableManager[T any] interface {
+	// CacheStats gets counts of how reads were served by the cache set with WithCache
+	CacheStats() CacheStats
+
+	// Close stops any startup still in progress and releases the manager's session. Operations on a
+	// closed manager return ErrManagerClosed.
+	Close()
+
 	// Count the number of records in the table.
 	Count(ctx context.Context) (int64, error)
 
@@ -20,12 +28,28 @@ type TableManager[T any] interface {
 	// CountByCustomQuery gets the number of records in a custom query.
 	CountByCustomQuery(ctx context.Context, queryBuilder QueryBuilderFn) (int64, error)
 
+	// CompareAndSwap replaces a record, provided the listed columns of the stored record still hold the
+	// values they have in expected. If no columns are listed, all non-key columns are compared. Returns
+	// ErrPreconditionFailed if the stored record did not match.
+	CompareAndSwap(ctx context.Context, expected *T, replacement *T, columns ...string) error
+
 	// Delete removes an object. Only the object keys need be present in T.
 	Delete(ctx context.Context, instance *T) error
 
+	// DeleteAndReturn removes a single row by its primary key values, returning the row removed. Keys must be
+	// specified in order. Returns ErrPreconditionFailed if a WithDeleteIf condition doesn't hold.
+	DeleteAndReturn(ctx context.Context, opts []DeleteOption, primaryKeys ...any) (*T, error)
+
 	// DeleteByPrimaryKey removes a single row by its primary key values. Keys must be specified in order.
 	DeleteByPrimaryKey(ctx context.Context, keys ...any) error
 
+	// DeletePartition removes every row of a partition, passing each row to any pre-delete hooks first.
+	// Preconditions are only supported for tables without clustering columns.
+	DeletePartition(ctx context.Context, opts []DeleteOption, partitionKeys ...any) error
+
+	// DeleteRange removes all records within a partition that fall within a range of clustering keys
+	DeleteRange(ctx context.Context, partitionKeys []any, r Range) error
+
 	// DeleteUsingOptions removes rows/columns as selected by the supplied options
 	DeleteUsingOptions(ctx context.Context, opts ...DeleteOption) error
 
@@ -46,13 +70,33 @@ type TableManager[T any] interface {
 	// GetByIndexedColumn gets the first record matching an index
 	GetByIndexedColumn(ctx context.Context, columnName string, value any, opts ...QueryOption) (*T, error)
 
+	// GetOrInsert inserts a record if no record with the same key exists. If one does, the existing
+	// record is returned instead. The boolean result is true if the record was inserted.
+	GetOrInsert(ctx context.Context, instance *T, opts ...InsertOption) (*T, bool, error)
+
+	// GetStatic gets the static columns of a partition. Only the partition keys and static
+	// columns will be populated in the result.
+	GetStatic(ctx context.Context, partitionKeys ...any) (*T, error)
+
 	// GetTableSpec gets the table specification for this table-manager
 	GetTableSpec() *metadata.TableSpecification
 
 	// GetSession gets the underlying session. Caveat emptor.
 	// This will be an implementation-specific type. If using gocqlx, this is a gocqlx.Session.
+	// Managers using lazy startup return nil until they are ready.
 	GetSession() any
 
+	// Ping runs a cheap query at the manager's read consistency, to check the table can be read
+	Ping(ctx context.Context) error
+
+	// Query runs a named query declared on the specification, binding its parameters by name. Every
+	// declared parameter must be given, and no others.
+	Query(ctx context.Context, name string, fn PageHandlerFn[T], params map[string]any, opts ...QueryOption) error
+
+	// Ready blocks until the manager has started, returning ErrNotReady if startup failed or the context
+	// ended first. Only managers created with WithLazyStartup can be unready.
+	Ready(ctx context.Context) error
+
 	// Insert a single record
 	Insert(ctx context.Context, instance *T, options ...InsertOption) error
 
@@ -60,7 +104,8 @@ type TableManager[T any] interface {
 	InsertOrReplace(ctx context.Context, instance *T, options ...InsertOption) error
 
 	// InsertBulk inserts many objects in parallel, up to a given number. If the concurrency limit is not set,
-	// then a default of DefaultBulkConcurrency is used.
+	// then a default of DefaultBulkConcurrency is used. If the concurrency is Adaptive, the manager's
+	// AdaptiveLimiter decides how many run at once.
 	InsertBulk(ctx context.Context, instances []*T, concurrency int, opts ...InsertOption) error
 
 	// Scan performs a paged scan of the table, processing each batch of records. If the ScanFn returns true,
@@ -79,16 +124,36 @@ type TableManager[T any] interface {
 	// SelectByIndexedColumn gets all records matching an indexed column
 	SelectByIndexedColumn(ctx context.Context, fn PageHandlerFn[T], columnName string, columnValue any, opts ...QueryOption) error
 
+	// SelectRange gets all records within a partition that fall within a range of clustering keys. Range
+	// bounds may cover several clustering columns, and follow the clustering order of the table.
+	SelectRange(ctx context.Context, fn PageHandlerFn[T], partitionKeys []any, r Range, opts ...QueryOption) error
+
 	// Update an object. Will error if the object does not exist.
 	Update(ctx context.Context, instance *T, opts ...UpdateOption) error
 
+	// UpdateFunc performs a read-modify-write of a single record by primary key. The current record
+	// is read at serial consistency and passed to fn, or nil if it does not exist. The result of fn is
+	// then written using an LWT, retrying with backoff if the record changed in the meantime. If fn
+	// returns nil, nothing is written.
+	UpdateFunc(ctx context.Context, keys []any, fn func(current *T) (*T, error), opts ...UpdateFuncOption) error
+
 	// Upsert overwrites or inserts an object.
 	Upsert(ctx context.Context, instance *T, opts ...UpsertOption) error
 
 	// UpsertBulk upserts many objects in parallel, up to a given number. If the concurrency limit is not set,
-	// then a default of DefaultBulkConcurrency is used.
+	// then a default of DefaultBulkConcurrency is used. If the concurrency is Adaptive, the manager's
+	// AdaptiveLimiter decides how many run at once.
 	UpsertBulk(ctx context.Context, instances []*T, concurrency int, opts ...UpsertOption) error
 
+	// UpsertStatic overwrites the static columns of a partition. Only the partition keys and static
+	// columns need be present in T. Returns ErrPreconditionFailed if a condition given in the options
+	// did not hold.
+	UpsertStatic(ctx context.Context, instance *T, opts ...UpsertOption) error
+
+	// Verify compares the live schema with the manager's specification, returning a *SchemaMismatchError
+	// listing any differences. Requires WithSchemaVerifier.
+	Verify(ctx context.Context) error
+
 	// AddPreChangeHook adds a pre-change hook. These hooks do not fire for deletes.
 	AddPreChangeHook(hook ChangeHook[T])
 
@@ -103,6 +168,13 @@ type TableManager[T any] interface {
 
 // ViewManager is an object that provides an abstraction over a view in ScyllaDB
 type ViewManager[T any] interface {
+	// CacheStats gets counts of how reads were served by the cache set with WithCache
+	CacheStats() CacheStats
+
+	// Close stops any startup still in progress and releases the manager's session. Operations on a
+	// closed manager return ErrManagerClosed.
+	Close()
+
 	// CountByPartitionKey gets the number of records in the partition.
 	CountByPartitionKey(ctx context.Context, partitionKeys ...any) (int64, error)
 
@@ -126,6 +198,17 @@ type ViewManager[T any] interface {
 	// GetByIndexedColumn gets the first record matching an index
 	GetByIndexedColumn(ctx context.Context, columnName string, value any, opts ...QueryOption) (*T, error)
 
+	// Ping runs a cheap query at the manager's read consistency, to check the table can be read
+	Ping(ctx context.Context) error
+
+	// Query runs a named query declared on the specification, binding its parameters by name. Every
+	// declared parameter must be given, and no others.
+	Query(ctx context.Context, name string, fn PageHandlerFn[T], params map[string]any, opts ...QueryOption) error
+
+	// Ready blocks until the manager has started, returning ErrNotReady if startup failed or the context
+	// ended first. Only managers created with WithLazyStartup can be unready.
+	Ready(ctx context.Context) error
+
 	// Scan performs a paged scan of the table, processing each batch of records. If the ScanFn returns true,
 	// the scan will continue advancing until no more records are returned.
 	Scan(ctx context.Context, fn PageHandlerFn[T], opts ...QueryOption) error
@@ -141,6 +224,14 @@ type ViewManager[T any] interface {
 
 	// SelectByIndexedColumn gets all records matching an indexed column
 	SelectByIndexedColumn(ctx context.Context, fn PageHandlerFn[T], columnName string, columnValue any, opts ...QueryOption) error
+
+	// SelectRange gets all records within a partition that fall within a range of clustering keys. Range
+	// bounds may cover several clustering columns, and follow the clustering order of the table.
+	SelectRange(ctx context.Context, fn PageHandlerFn[T], partitionKeys []any, r Range, opts ...QueryOption) error
+
+	// Verify compares the live schema with the manager's specification, returning a *SchemaMismatchError
+	// listing any differences. Requires WithSchemaVerifier.
+	Verify(ctx context.Context) error
 }
 
 // InsertOption is an interface that describes options that can mutate an insert
@@ -149,17 +240,29 @@ type InsertOption interface {
 
 	// isPrecondition indicates if this option applies a precondition to the query
 	isPrecondition() bool
+
+	// skipsNilColumns indicates if nil or empty non-key columns should be left unset
+	skipsNilColumns() bool
+
+	// timeoutBudget overrides the budget for the call, if greater than zero
+	timeoutBudget() time.Duration
 }
 
 type DeleteOption interface {
 	applyToQuery(query *gocqlx.Queryx) *gocqlx.Queryx
-	applyToBuilder(builder *qb.DeleteBuilder) *qb.DeleteBuilder
+	applyToDeleteBuilder(builder *qb.DeleteBuilder) *qb.DeleteBuilder
 	bindings() []any // Values bound to a query
 
 	conditions() []qb.Cmp
 
+	// expectedValues gets the column values a precondition requires, or nil if they aren't known
+	expectedValues() map[string]any
+
 	// isPrecondition indicates if this option applies a precondition to the query
 	isPrecondition() bool
+
+	// timeoutBudget overrides the budget for the call, if greater than zero
+	timeoutBudget() time.Duration
 }
 
 // QueryOption is an interface that describes options that can mutate a scan.
@@ -168,6 +271,10 @@ type QueryOption interface {
 	applyToBuilder(builder *qb.SelectBuilder) *qb.SelectBuilder
 	columns() []string
 	bindings() []any // Values bound to a query
+
+	prefetchPages() int             // Pages to fetch ahead of the page handler
+	pageTimingFn() func(PageTiming) // Receives the timing of each page
+	timeoutBudget() time.Duration   // Overrides the budget for the call, or each page, if greater than zero
 }
 
 // UpdateOption is an interface that describes options that can mutate an update
@@ -180,6 +287,12 @@ type UpdateOption interface {
 
 	// isPrecondition indicates if this option applies a precondition to the query
 	isPrecondition() bool
+
+	// skipsNilColumns indicates if nil or empty non-key columns should be left unset
+	skipsNilColumns() bool
+
+	// timeoutBudget overrides the budget for the call, if greater than zero
+	timeoutBudget() time.Duration
 }
 
 // UpsertOption is an option that can be used for inserts or update
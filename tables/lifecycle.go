@@ -0,0 +1,142 @@
+package tables
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/scylladb/gocqlx/v3"
+)
+
+// BeforeInserter is implemented by records that prepare themselves before being inserted, such as to
+// stamp a creation time. BeforeInsert is called before Validate and any pre-change hooks.
+type BeforeInserter interface {
+	BeforeInsert(ctx context.Context) error
+}
+
+// BeforeUpdater is implemented by records that prepare themselves before being updated or upserted, such
+// as to stamp a modification time. BeforeUpdate is called before Validate and any pre-change hooks.
+type BeforeUpdater interface {
+	BeforeUpdate(ctx context.Context) error
+}
+
+// Validator is implemented by records that check themselves before being written. Errors are returned
+// wrapped in ErrInvalidRecord, and the write is not made.
+type Validator interface {
+	Validate() error
+}
+
+// AfterLoader is implemented by records that finish themselves after being read, such as to fill in
+// derived fields. AfterLoad is called for every record read, including each record of a page.
+type AfterLoader interface {
+	AfterLoad(ctx context.Context) error
+}
+
+// recordLifecycle holds the lifecycle interfaces a record type implements. They are found once, when a
+// manager is created, so that calls on types without them cost nothing.
+type recordLifecycle[T any] struct {
+	hasBeforeInsert bool
+	hasBeforeUpdate bool
+	hasValidate     bool
+	hasAfterLoad    bool
+}
+
+// newRecordLifecycle finds the lifecycle interfaces implemented by a record type
+func newRecordLifecycle[T any]() recordLifecycle[T] {
+	record := any(new(T))
+	_, hasBeforeInsert := record.(BeforeInserter)
+	_, hasBeforeUpdate := record.(BeforeUpdater)
+	_, hasValidate := record.(Validator)
+	_, hasAfterLoad := record.(AfterLoader)
+
+	return recordLifecycle[T]{
+		hasBeforeInsert: hasBeforeInsert,
+		hasBeforeUpdate: hasBeforeUpdate,
+		hasValidate:     hasValidate,
+		hasAfterLoad:    hasAfterLoad,
+	}
+}
+
+// beforeInsert calls BeforeInsert then Validate on a record about to be inserted
+func (l recordLifecycle[T]) beforeInsert(ctx context.Context, instance *T) error {
+	if l.hasBeforeInsert {
+		if err := any(instance).(BeforeInserter).BeforeInsert(ctx); err != nil {
+			return fmt.Errorf("error executing BeforeInsert: %w", err)
+		}
+	}
+	return l.validate(instance)
+}
+
+// beforeUpdate calls BeforeUpdate then Validate on a record about to be updated or upserted
+func (l recordLifecycle[T]) beforeUpdate(ctx context.Context, instance *T) error {
+	if l.hasBeforeUpdate {
+		if err := any(instance).(BeforeUpdater).BeforeUpdate(ctx); err != nil {
+			return fmt.Errorf("error executing BeforeUpdate: %w", err)
+		}
+	}
+	return l.validate(instance)
+}
+
+// validate calls Validate on a record about to be written
+func (l recordLifecycle[T]) validate(instance *T) error {
+	if !l.hasValidate {
+		return nil
+	}
+	if err := any(instance).(Validator).Validate(); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidRecord, err)
+	}
+	return nil
+}
+
+// afterLoad calls AfterLoad on a record that has been read
+func (l recordLifecycle[T]) afterLoad(ctx context.Context, instance *T) error {
+	if !l.hasAfterLoad || instance == nil {
+		return nil
+	}
+	if err := any(instance).(AfterLoader).AfterLoad(ctx); err != nil {
+		return fmt.Errorf("error executing AfterLoad: %w", err)
+	}
+	return nil
+}
+
+// afterLoadPage wraps a page handler so that AfterLoad is called on each record before the handler sees it
+func (l recordLifecycle[T]) afterLoadPage(fn PageHandlerFn[T]) PageHandlerFn[T] {
+	if !l.hasAfterLoad {
+		return fn
+	}
+
+	return func(ctx context.Context, records []*T, originalPagingState []byte, newPagingState []byte) (bool, error) {
+		for _, record := range records {
+			if err := l.afterLoad(ctx, record); err != nil {
+				return false, err
+			}
+		}
+		return fn(ctx, records, originalPagingState, newPagingState)
+	}
+}
+
+// getRecord fetches a single record, running the read through the fault injector first and calling the
+// record's AfterLoad once it has been read
+func (t *baseManagerImpl[T]) getRecord(ctx context.Context, query *gocqlx.Queryx) (*T, error) {
+	var target T
+	if err := t.faultyGet(ctx, query, &target); err != nil {
+		return &target, err
+	}
+	return &target, t.lifecycle.afterLoad(ctx, &target)
+}
+
+// prepareInsert runs the lifecycle methods of a record about to be inserted, then the pre-change hooks
+func (t *tableManagerImpl[T]) prepareInsert(ctx context.Context, instance *T) error {
+	if err := t.lifecycle.beforeInsert(ctx, instance); err != nil {
+		return err
+	}
+	return t.runPreHooks(ctx, instance)
+}
+
+// prepareUpdate runs the lifecycle methods of a record about to be updated or upserted, then the
+// pre-change hooks
+func (t *tableManagerImpl[T]) prepareUpdate(ctx context.Context, instance *T) error {
+	if err := t.lifecycle.beforeUpdate(ctx, instance); err != nil {
+		return err
+	}
+	return t.runPreHooks(ctx, instance)
+}
@@ -0,0 +1,92 @@
+package tables
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// lifecycleRecord implements every lifecycle interface, recording the calls made
+type lifecycleRecord struct {
+	Name  string
+	calls []string
+}
+
+func (r *lifecycleRecord) BeforeInsert(ctx context.Context) error {
+	r.calls = append(r.calls, "BeforeInsert")
+	return nil
+}
+
+func (r *lifecycleRecord) BeforeUpdate(ctx context.Context) error {
+	r.calls = append(r.calls, "BeforeUpdate")
+	return nil
+}
+
+func (r *lifecycleRecord) Validate() error {
+	r.calls = append(r.calls, "Validate")
+	if r.Name == "" {
+		return errors.New("name is required")
+	}
+	return nil
+}
+
+func (r *lifecycleRecord) AfterLoad(ctx context.Context) error {
+	r.calls = append(r.calls, "AfterLoad")
+	return nil
+}
+
+// plainRecord implements no lifecycle interfaces
+type plainRecord struct {
+	Name string
+}
+
+// TestRecordLifecycleDetection checks the lifecycle interfaces of a type are found
+func TestRecordLifecycleDetection(t *testing.T) {
+	// Act
+	full := newRecordLifecycle[lifecycleRecord]()
+	plain := newRecordLifecycle[plainRecord]()
+
+	// Assert
+	require.Equal(t, recordLifecycle[lifecycleRecord]{
+		hasBeforeInsert: true,
+		hasBeforeUpdate: true,
+		hasValidate:     true,
+		hasAfterLoad:    true,
+	}, full, "Should find every interface")
+	require.Equal(t, recordLifecycle[plainRecord]{}, plain, "Should find no interfaces")
+}
+
+// TestRecordLifecycleCalls checks lifecycle methods are called in order, and validation failures reported
+func TestRecordLifecycleCalls(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	lifecycle := newRecordLifecycle[lifecycleRecord]()
+	inserted := &lifecycleRecord{Name: "inserted"}
+	updated := &lifecycleRecord{Name: "updated"}
+	invalid := &lifecycleRecord{}
+	loaded := []*lifecycleRecord{{Name: "first"}, {Name: "second"}}
+
+	// Act
+	errInsert := lifecycle.beforeInsert(ctx, inserted)
+	errUpdate := lifecycle.beforeUpdate(ctx, updated)
+	errInvalid := lifecycle.beforeInsert(ctx, invalid)
+	handled := 0
+	_, errPage := lifecycle.afterLoadPage(func(ctx context.Context, records []*lifecycleRecord, originalPagingState []byte, newPagingState []byte) (bool, error) {
+		handled = len(records)
+		return true, nil
+	})(ctx, loaded, nil, nil)
+
+	// Assert
+	require.NoError(t, errInsert, "Should not error preparing an insert")
+	require.Equal(t, []string{"BeforeInsert", "Validate"}, inserted.calls, "Should prepare then validate inserts")
+	require.NoError(t, errUpdate, "Should not error preparing an update")
+	require.Equal(t, []string{"BeforeUpdate", "Validate"}, updated.calls, "Should prepare then validate updates")
+	require.ErrorIs(t, errInvalid, ErrInvalidRecord, "Should report validation failures")
+	require.NoError(t, errPage, "Should not error handling the page")
+	require.Equal(t, 2, handled, "Should pass the page to the handler")
+	for _, record := range loaded {
+		require.Equal(t, []string{"AfterLoad"}, record.calls, "Should call AfterLoad on each record")
+	}
+}
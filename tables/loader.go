@@ -0,0 +1,298 @@
+package tables
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/scylladb/go-reflectx"
+	"github.com/scylladb/gocqlx/v3"
+	"github.com/scylladb/gocqlx/v3/qb"
+	"golang.org/x/sync/errgroup"
+)
+
+// Loader batches primary key lookups against a table, in the style of a DataLoader. Calls to Load
+// made within a short window are collected, identical keys are de-duplicated, and the batch is fetched
+// using as few queries as the table's key layout allows. Results are cached for the lifetime of the
+// loader, so a loader is typically created per request.
+type Loader[T any] struct {
+	manager    TableManager[T]
+	mapper     *reflectx.Mapper
+	tableName  string
+	partKey    []string
+	sortKey    []string
+	keys       keyEncoder // Builds comparable keys from primary key values
+	params     loaderParameters
+	mu         sync.Mutex
+	cache      map[string]*loaderCall[T] // All calls made, by key
+	pending    []*loaderCall[T]          // Calls waiting to be dispatched
+	pendingCtx context.Context           // Context of the first pending call
+	timer      *time.Timer               // Dispatches the pending calls at the end of the window
+}
+
+// loaderCall is a single distinct key being loaded
+type loaderCall[T any] struct {
+	keys   []any
+	key    string // Encoded form of the keys
+	done   chan struct{}
+	result *T
+	err    error
+}
+
+// NewLoader creates a loader over a table manager
+func NewLoader[T any](manager TableManager[T], opts ...LoaderOption) *Loader[T] {
+	params := loaderParameters{
+		wait:        DefaultLoaderWait,
+		maxBatch:    DefaultLoaderMaxBatch,
+		concurrency: DefaultBulkConcurrency,
+	}
+	for _, opt := range opts {
+		opt(&params)
+	}
+	params.maxBatch = max(params.maxBatch, 1)
+	params.concurrency = max(params.concurrency, 1)
+
+	spec := manager.GetTableSpec()
+	md := spec.ToCQLX().Metadata()
+
+	return &Loader[T]{
+		manager:   manager,
+		mapper:    managerMapper(manager.GetSession()),
+		tableName: md.Name,
+		partKey:   md.PartKey,
+		sortKey:   md.SortKey,
+		keys:      newKeyEncoder(spec, append(append([]string{}, md.PartKey...), md.SortKey...)),
+		params:    params,
+		cache:     map[string]*loaderCall[T]{},
+	}
+}
+
+// Load gets a record by its full primary key, returning nil if it does not exist. The lookup is
+// batched with other calls made around the same time.
+func (l *Loader[T]) Load(ctx context.Context, keys ...any) (*T, error) {
+	if len(keys) != len(l.partKey)+len(l.sortKey) {
+		return nil, fmt.Errorf("%w: expected %d primary key values, got %d", ErrKeyCount, len(l.partKey)+len(l.sortKey), len(keys))
+	}
+
+	call, err := l.enqueue(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case <-call.done:
+		return call.result, call.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Clear removes all cached results, so that later loads go back to the database
+func (l *Loader[T]) Clear() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.cache = map[string]*loaderCall[T]{}
+	for _, call := range l.pending {
+		l.cache[call.key] = call
+	}
+}
+
+// ClearKey removes the cached result for a single primary key
+func (l *Loader[T]) ClearKey(keys ...any) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	key, err := l.keys.encode(keys)
+	if err != nil {
+		return // Keys that can't be encoded were never loaded
+	}
+	if call, ok := l.cache[key]; ok && isDone(call) {
+		delete(l.cache, key)
+	}
+}
+
+// enqueue finds the call for a key, adding it to the pending batch if it is not already known. Returns
+// ErrKeyType if the keys can't be bound to the key columns.
+func (l *Loader[T]) enqueue(ctx context.Context, keys []any) (*loaderCall[T], error) {
+	key, err := l.keys.encode(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if call, ok := l.cache[key]; ok {
+		return call, nil
+	}
+
+	call := &loaderCall[T]{
+		keys: keys,
+		key:  key,
+		done: make(chan struct{}),
+	}
+	l.cache[key] = call
+	l.pending = append(l.pending, call)
+
+	if len(l.pending) == 1 {
+		// A batch outlives any single caller, so we don't let one caller's cancellation fail the others
+		l.pendingCtx = context.WithoutCancel(ctx)
+		l.timer = time.AfterFunc(l.params.wait, l.dispatchPending)
+	}
+	if len(l.pending) >= l.params.maxBatch {
+		l.timer.Stop()
+		go l.dispatch(l.pendingCtx, l.takePending())
+	}
+
+	return call, nil
+}
+
+// dispatchPending dispatches whatever is pending at the end of a window
+func (l *Loader[T]) dispatchPending() {
+	l.mu.Lock()
+	ctx := l.pendingCtx
+	batch := l.takePending()
+	l.mu.Unlock()
+
+	if len(batch) > 0 {
+		l.dispatch(ctx, batch)
+	}
+}
+
+// takePending removes the pending calls. Must be called with the lock held.
+func (l *Loader[T]) takePending() []*loaderCall[T] {
+	batch := l.pending
+	l.pending = nil
+	l.pendingCtx = nil
+	return batch
+}
+
+// dispatch fetches a batch of calls, grouping them into as few queries as the key layout allows, and
+// completes each call with its result.
+func (l *Loader[T]) dispatch(ctx context.Context, batch []*loaderCall[T]) {
+	grp, grpCtx := errgroup.WithContext(ctx)
+	grp.SetLimit(l.params.concurrency)
+
+	for _, group := range l.groupCalls(batch) {
+		grp.Go(func() error {
+			err := l.fetchGroup(grpCtx, group)
+			l.complete(group, err)
+			return nil
+		})
+	}
+
+	_ = grp.Wait()
+}
+
+// groupCalls splits a batch into groups that can each be fetched with one query. Tables with a single
+// partition key column and no clustering columns are fetched with one partition key IN query. Tables with
+// a single clustering column are fetched with a clustering key IN query per partition. Anything else is
+// fetched one key at a time.
+func (l *Loader[T]) groupCalls(batch []*loaderCall[T]) [][]*loaderCall[T] {
+	switch {
+	case len(l.partKey) == 1 && len(l.sortKey) == 0:
+		return [][]*loaderCall[T]{batch}
+	case len(l.sortKey) == 1:
+		var groups [][]*loaderCall[T]
+		byPartition := map[string]int{}
+		for _, call := range batch {
+			// The partition key is a prefix of the call's keys, which have already been encoded
+			key, _ := l.keys.encode(call.keys[:len(l.partKey)])
+			idx, ok := byPartition[key]
+			if !ok {
+				idx = len(groups)
+				byPartition[key] = idx
+				groups = append(groups, nil)
+			}
+			groups[idx] = append(groups[idx], call)
+		}
+		return groups
+	default:
+		groups := make([][]*loaderCall[T], len(batch))
+		for i, call := range batch {
+			groups[i] = []*loaderCall[T]{call}
+		}
+		return groups
+	}
+}
+
+// fetchGroup fetches a group of calls with a single query, filling in the results of those found
+func (l *Loader[T]) fetchGroup(ctx context.Context, group []*loaderCall[T]) error {
+	if len(group) == 1 {
+		result, err := l.manager.GetByPrimaryKey(ctx, group[0].keys...)
+		group[0].result = result
+		return err
+	}
+
+	// All calls in the group share every key column but the last, which we match with IN
+	keyColumns := append(append([]string{}, l.partKey...), l.sortKey...)
+	lastColumn := keyColumns[len(keyColumns)-1]
+	predicates := make([]qb.Cmp, 0, len(keyColumns))
+	bindings := make([]any, 0, len(keyColumns))
+	for i, col := range keyColumns[:len(keyColumns)-1] {
+		predicates = append(predicates, qb.Eq(col))
+		bindings = append(bindings, group[0].keys[i])
+	}
+
+	inValues := make([]any, len(group))
+	for i, call := range group {
+		inValues[i] = call.keys[len(keyColumns)-1]
+	}
+	predicates = append(predicates, qb.In(lastColumn))
+	bindings = append(bindings, inValues)
+
+	scanner := &GreedyScanner[T]{}
+	scanner.Preallocate(len(group))
+	err := l.manager.SelectByCustomQuery(ctx, func(ctx context.Context, sess gocqlx.Session) *gocqlx.Queryx {
+		stmt, params := qb.Select(l.tableName).Where(predicates...).ToCql()
+		return sess.Query(stmt, params).WithContext(ctx).Bind(bindings...)
+	}, scanner.OnPage)
+	if err != nil {
+		return err
+	}
+
+	byKey := make(map[string]*loaderCall[T], len(group))
+	for _, call := range group {
+		byKey[call.key] = call
+	}
+	for _, record := range scanner.Result() {
+		key, errKey := l.keys.encode(fieldValues(l.mapper, record, keyColumns))
+		if errKey != nil {
+			return errKey
+		}
+		if call, ok := byKey[key]; ok {
+			call.result = record
+		}
+	}
+
+	return nil
+}
+
+// complete finishes a group of calls. Failed calls are removed from the cache so they can be retried.
+func (l *Loader[T]) complete(group []*loaderCall[T], err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, call := range group {
+		if err != nil {
+			call.result = nil
+			call.err = err
+			if l.cache[call.key] == call {
+				delete(l.cache, call.key)
+			}
+		}
+		close(call.done)
+	}
+}
+
+// isDone indicates if a call has completed
+func isDone[T any](call *loaderCall[T]) bool {
+	select {
+	case <-call.done:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,156 @@
+package tables_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/zeroflucs-given/charybdis/tables"
+)
+
+// TestLoaderBatchesLookups checks concurrent loads are batched and fanned back to their callers
+func TestLoaderBatchesLookups(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+	manager, err := tables.NewTableManager[OrderItem](ctx,
+		tables.WithCluster(testClusterConfig),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(OrderItemsTableSpec))
+	require.NoError(t, err, "Should not error starting up")
+
+	// Arrange
+	toInsert := make([]*OrderItem, 0, 20)
+	for order := range 2 {
+		for item := range 10 {
+			toInsert = append(toInsert, &OrderItem{
+				OrderID:  fmt.Sprintf("loader-order-%d", order),
+				ItemID:   fmt.Sprintf("loader-item-%d", item),
+				Quantity: order*10 + item,
+			})
+		}
+	}
+	errInsert := manager.InsertBulk(ctx, toInsert, -1)
+	require.NoError(t, errInsert, "Should not error inserting")
+
+	loader := tables.NewLoader(manager)
+
+	// Act - every key is requested twice, plus one that doesn't exist
+	results := make([]*OrderItem, 41)
+	grp, grpCtx := errgroup.WithContext(ctx)
+	for i := range 40 {
+		grp.Go(func() error {
+			var errLoad error
+			results[i], errLoad = loader.Load(grpCtx, fmt.Sprintf("loader-order-%d", (i/10)%2), fmt.Sprintf("loader-item-%d", i%10))
+			return errLoad
+		})
+	}
+	grp.Go(func() error {
+		var errLoad error
+		results[40], errLoad = loader.Load(grpCtx, "loader-order-0", "loader-item-missing")
+		return errLoad
+	})
+	errWait := grp.Wait()
+
+	// Assert
+	require.NoError(t, errWait, "Should not error loading")
+	for i := range 40 {
+		require.NotNil(t, results[i], "Should find existing records")
+		require.Equal(t, ((i/10)%2)*10+i%10, results[i].Quantity, "Should get the record for the right key")
+	}
+	require.Nil(t, results[40], "Should get nil for a missing record")
+
+	_, errKeys := loader.Load(ctx, "loader-order-0")
+	require.ErrorIs(t, errKeys, tables.ErrKeyCount, "Should require the full primary key")
+}
+
+// TestLoaderClear checks cached results are refreshed once cleared
+func TestLoaderClear(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+	manager, err := tables.NewTableManager[Order](ctx,
+		tables.WithCluster(testClusterConfig),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(OrdersTableSpec))
+	require.NoError(t, err, "Should not error starting up")
+
+	// Arrange
+	errInsert := manager.Insert(ctx, &Order{
+		OrderID:         "loader-clear-1",
+		ShippingAddress: testAddress(1, "Cached Street", "Somerville"),
+	})
+	require.NoError(t, errInsert, "Should not error inserting")
+
+	loader := tables.NewLoader(manager)
+	first, errFirst := loader.Load(ctx, "loader-clear-1")
+	require.NoError(t, errFirst, "Should not error loading")
+
+	errUpdate := manager.Upsert(ctx, &Order{
+		OrderID:         "loader-clear-1",
+		ShippingAddress: testAddress(1, "Fresh Street", "Somerville"),
+	})
+	require.NoError(t, errUpdate, "Should not error updating")
+
+	// Act
+	cached, errCached := loader.Load(ctx, "loader-clear-1")
+	loader.Clear()
+	fresh, errFresh := loader.Load(ctx, "loader-clear-1")
+
+	// Assert
+	require.NoError(t, errCached, "Should not error loading from cache")
+	require.Same(t, first, cached, "Should get the cached record before clearing")
+	require.NoError(t, errFresh, "Should not error loading after clearing")
+	require.Equal(t, testAddress(1, "Fresh Street", "Somerville"), fresh.ShippingAddress, "Should see the update after clearing")
+}
+
+// TestLoaderTimestampKeys checks records are matched to their loads when timestamp keys are given
+// with a location and monotonic clock reading the database doesn't return
+func TestLoaderTimestampKeys(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+	manager, err := tables.NewTableManager[OrderEvent](ctx,
+		tables.WithCluster(testClusterConfig),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(OrderEventsTableSpec))
+	require.NoError(t, err, "Should not error starting up")
+
+	// Arrange - time.Now carries a monotonic reading, and the keys are given in another location
+	location := time.FixedZone("UTC+10", 10*60*60)
+	base := time.Now().In(location)
+	keys := make([]time.Time, 5)
+	for i := range keys {
+		keys[i] = base.Add(time.Duration(i) * time.Minute)
+		errInsert := manager.Insert(ctx, &OrderEvent{
+			OrderID:    "loader-events-1",
+			OccurredAt: keys[i],
+			Status:     fmt.Sprintf("status-%d", i),
+		})
+		require.NoError(t, errInsert, "Should not error inserting")
+	}
+
+	loader := tables.NewLoader(manager)
+
+	// Act
+	results := make([]*OrderEvent, len(keys))
+	grp, grpCtx := errgroup.WithContext(ctx)
+	for i, key := range keys {
+		grp.Go(func() error {
+			var errLoad error
+			results[i], errLoad = loader.Load(grpCtx, "loader-events-1", key)
+			return errLoad
+		})
+	}
+	errWait := grp.Wait()
+	_, errType := loader.Load(ctx, "loader-events-1", "not a time")
+
+	// Assert
+	require.NoError(t, errWait, "Should not error loading")
+	for i := range keys {
+		require.NotNil(t, results[i], "Should match each record to its load")
+		require.Equal(t, fmt.Sprintf("status-%d", i), results[i].Status, "Should get the record for the right key")
+	}
+	require.ErrorIs(t, errType, tables.ErrKeyType, "Should reject keys that can't be bound to their column")
+}
@@ -0,0 +1,182 @@
+package tables
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/scylladb/go-reflectx"
+	"go.uber.org/zap"
+)
+
+// lwtOutcome is the resolved outcome of an LWT whose result was ambiguous
+type lwtOutcome int
+
+const (
+	lwtUnknown    lwtOutcome = iota // We can't tell if the LWT was applied
+	lwtApplied                      // The row holds the values we wrote
+	lwtNotApplied                   // The row shows our write did not happen, so it is safe to retry
+)
+
+// lwtExpectation describes what an LWT attempted, so that an ambiguous outcome can be resolved by
+// reading the row back
+type lwtExpectation struct {
+	keys      []any          // Primary key of the row
+	written   map[string]any // Column values being written
+	expected  map[string]any // Column values the condition requires, if known
+	notExists bool           // The condition requires that the row does not exist
+	deletes   bool           // The LWT removes the row rather than writing values
+}
+
+// lwtResolver runs LWTs, resolving write timeouts by reading the row back at serial consistency
+// rather than blindly retrying. A serial read completes any in-progress Paxos round for the row,
+// so it reflects whether our proposal was accepted.
+type lwtResolver[T any] struct {
+	mapper     *reflectx.Mapper
+	logger     *zap.Logger
+	readSerial func(ctx context.Context, keys ...any) (*T, error)
+}
+
+// lwtResolver creates a resolver for LWTs against this table, reading at the given serial consistency.
+// Non-serial consistency levels select one to match the write consistency.
+func (t *tableManagerImpl[T]) lwtResolver(serialConsistency gocql.Consistency) lwtResolver[T] {
+	if !serialConsistency.IsSerial() {
+		serialConsistency = gocql.Serial
+		if t.writeConsistency == gocql.LocalQuorum || t.writeConsistency == gocql.LocalOne {
+			serialConsistency = gocql.LocalSerial
+		}
+	}
+
+	return lwtResolver[T]{
+		mapper: t.Session.Mapper,
+		logger: t.Logger,
+		readSerial: func(ctx context.Context, keys ...any) (*T, error) {
+			return t.getSerial(ctx, serialConsistency, keys...)
+		},
+	}
+}
+
+// writtenValues gets the values a write will set for the given columns, leaving out any that will be unset
+func (t *tableManagerImpl[T]) writtenValues(instance *T, columns []string, skipNil bool) map[string]any {
+	transform := t.unsetTransformer(skipNil)
+	values := t.columnValues(instance, columns)
+
+	result := make(map[string]any, len(columns))
+	for i, col := range columns {
+		if transform != nil && transform(col, values[i]) == gocql.UnsetValue {
+			continue
+		}
+		result[col] = values[i]
+	}
+
+	return result
+}
+
+// exec runs an LWT attempt, retrying on write timeouts. Because a timed out LWT may still have been
+// applied, the row is read back after each timeout. Writes that are found to have been applied are
+// reported as such, writes that definitely did not happen are retried, and anything else returns
+// ErrOutcomeUnknown.
+func (r lwtResolver[T]) exec(ctx context.Context, operation string, attempt func() (bool, error), expect lwtExpectation) (bool, error) {
+	st := time.Now()
+
+	for {
+		applied, err := attempt()
+		if err == nil {
+			return applied, nil
+		}
+
+		var wto *gocql.RequestErrWriteTimeout
+		var casUnknown *gocql.RequestErrCASWriteUnknown
+		if !errors.As(err, &wto) && !errors.As(err, &casUnknown) {
+			return false, err
+		}
+		reportAttempt(ctx, err)
+
+		outcome, errResolve := r.resolve(ctx, expect)
+		if errResolve != nil {
+			return false, fmt.Errorf("%w: resolving %s after timeout: %w", ErrOutcomeUnknown, operation, errResolve)
+		}
+
+		fields := []zap.Field{
+			zap.String("operation", operation),
+			zap.Error(err),
+			zap.Duration("execution_time_to_now", time.Since(st)),
+		}
+		if wto != nil {
+			fields = append(fields, zap.String("writeType", wto.WriteType))
+		}
+
+		switch outcome {
+		case lwtApplied:
+			r.logger.Debug("lwt timed out but was applied", fields...)
+			return true, nil
+		case lwtNotApplied:
+			r.logger.Debug("lwt timed out and was not applied, retrying", fields...)
+		default:
+			r.logger.Debug("lwt timed out with unknown outcome", fields...)
+			return false, fmt.Errorf("%w: %s", ErrOutcomeUnknown, operation)
+		}
+	}
+}
+
+// resolve reads the row back and decides the outcome of an ambiguous LWT. If the row holds the values
+// we wrote, or is gone when we were deleting it, we assume we did so. If the row shows the condition
+// still holds, our write can't have happened. Anything else could be our write overwritten by another,
+// or another write winning the race.
+func (r lwtResolver[T]) resolve(ctx context.Context, expect lwtExpectation) (lwtOutcome, error) {
+	current, err := r.readSerial(ctx, expect.keys...)
+	if err != nil {
+		return lwtUnknown, err
+	}
+
+	switch {
+	case expect.deletes && current == nil:
+		return lwtApplied, nil
+	case !expect.deletes && current != nil && r.matches(current, expect.written):
+		return lwtApplied, nil
+	case current == nil && expect.notExists:
+		return lwtNotApplied, nil
+	case current != nil && expect.expected != nil && r.matches(current, expect.expected):
+		return lwtNotApplied, nil
+	default:
+		return lwtUnknown, nil
+	}
+}
+
+// matches checks a row holds the given column values
+func (r lwtResolver[T]) matches(current *T, values map[string]any) bool {
+	columns := make([]string, 0, len(values))
+	for col := range values {
+		columns = append(columns, col)
+	}
+
+	for i, actual := range fieldValues(r.mapper, current, columns) {
+		if !valuesEqual(values[columns[i]], actual) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// valuesEqual compares a written value with one read back. Empty collections read back as nil, and
+// timestamps are stored to the millisecond, so these are allowed for.
+func valuesEqual(written any, read any) bool {
+	if reflect.DeepEqual(written, read) {
+		return true
+	}
+	if isNilOrEmpty(written) && isNilOrEmpty(read) {
+		return true
+	}
+
+	writtenTime, okWritten := written.(time.Time)
+	readTime, okRead := read.(time.Time)
+	if okWritten && okRead {
+		return writtenTime.Truncate(time.Millisecond).Equal(readTime.Truncate(time.Millisecond))
+	}
+
+	return false
+}
@@ -0,0 +1,206 @@
+package tables
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gocql/gocql"
+	"github.com/scylladb/gocqlx/v3"
+	"github.com/scylladb/gocqlx/v3/qb"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// lwtRecord is a record used to exercise LWT resolution
+type lwtRecord struct {
+	ID    string `cql:"id"`
+	Value string `cql:"value"`
+}
+
+// stubLWTSession stands in for a session, timing out the first CAS attempt and serving serial reads
+// from a fixed row
+type stubLWTSession struct {
+	timeouts int
+	attempts int
+	row      *lwtRecord
+}
+
+// execCAS times out until the configured number of timeouts has been reached
+func (s *stubLWTSession) execCAS() (bool, error) {
+	s.attempts++
+	if s.attempts <= s.timeouts {
+		return false, &gocql.RequestErrWriteTimeout{WriteType: "CAS"}
+	}
+	return true, nil
+}
+
+// resolver creates a resolver reading from the stub
+func (s *stubLWTSession) resolver() lwtResolver[lwtRecord] {
+	return lwtResolver[lwtRecord]{
+		mapper: gocqlx.DefaultMapper,
+		logger: zap.NewNop(),
+		readSerial: func(ctx context.Context, keys ...any) (*lwtRecord, error) {
+			return s.row, nil
+		},
+	}
+}
+
+// TestLWTTimeoutApplied checks a timed out LWT whose values are found on read back is reported as applied
+func TestLWTTimeoutApplied(t *testing.T) {
+	// Arrange
+	session := &stubLWTSession{
+		timeouts: 1,
+		row:      &lwtRecord{ID: "a", Value: "written"},
+	}
+
+	// Act
+	applied, err := session.resolver().exec(context.Background(), "update", session.execCAS, lwtExpectation{
+		keys:    []any{"a"},
+		written: map[string]any{"value": "written"},
+	})
+
+	// Assert
+	require.NoError(t, err, "Should not error")
+	require.True(t, applied, "Should report the write as applied")
+	require.Equal(t, 1, session.attempts, "Should not retry an applied write")
+}
+
+// TestLWTTimeoutNotApplied checks a timed out LWT that definitely did not happen is retried
+func TestLWTTimeoutNotApplied(t *testing.T) {
+	// Arrange
+	session := &stubLWTSession{
+		timeouts: 1,
+	}
+
+	// Act
+	applied, err := session.resolver().exec(context.Background(), "insert", session.execCAS, lwtExpectation{
+		keys:      []any{"a"},
+		written:   map[string]any{"value": "written"},
+		notExists: true,
+	})
+
+	// Assert
+	require.NoError(t, err, "Should not error")
+	require.True(t, applied, "Should apply on retry")
+	require.Equal(t, 2, session.attempts, "Should have retried once")
+}
+
+// TestLWTTimeoutExpectedStillHolds checks a timed out LWT is retried when its condition still holds
+func TestLWTTimeoutExpectedStillHolds(t *testing.T) {
+	// Arrange
+	session := &stubLWTSession{
+		timeouts: 1,
+		row:      &lwtRecord{ID: "a", Value: "original"},
+	}
+
+	// Act
+	applied, err := session.resolver().exec(context.Background(), "compare and set", session.execCAS, lwtExpectation{
+		keys:     []any{"a"},
+		written:  map[string]any{"value": "written"},
+		expected: map[string]any{"value": "original"},
+	})
+
+	// Assert
+	require.NoError(t, err, "Should not error")
+	require.True(t, applied, "Should apply on retry")
+	require.Equal(t, 2, session.attempts, "Should have retried once")
+}
+
+// TestLWTTimeoutUnknown checks a timed out LWT that can't be resolved returns ErrOutcomeUnknown
+func TestLWTTimeoutUnknown(t *testing.T) {
+	// Arrange
+	session := &stubLWTSession{
+		timeouts: 1,
+		row:      &lwtRecord{ID: "a", Value: "someone else"},
+	}
+
+	// Act
+	applied, err := session.resolver().exec(context.Background(), "update", session.execCAS, lwtExpectation{
+		keys:     []any{"a"},
+		written:  map[string]any{"value": "written"},
+		expected: map[string]any{"value": "original"},
+	})
+
+	// Assert
+	require.ErrorIs(t, err, ErrOutcomeUnknown, "Should report the outcome as unknown")
+	require.False(t, applied, "Should not report the write as applied")
+	require.Equal(t, 1, session.attempts, "Should not retry an unknown outcome")
+}
+
+// TestLWTDeleteTimeoutRowGone checks a timed out delete is reported as applied when the row is gone
+func TestLWTDeleteTimeoutRowGone(t *testing.T) {
+	// Arrange
+	session := &stubLWTSession{
+		timeouts: 1,
+	}
+
+	// Act
+	applied, err := session.resolver().exec(context.Background(), "delete", session.execCAS, lwtExpectation{
+		keys:     []any{"a"},
+		expected: map[string]any{},
+		deletes:  true,
+	})
+
+	// Assert
+	require.NoError(t, err, "Should not error")
+	require.True(t, applied, "Should report the delete as applied")
+	require.Equal(t, 1, session.attempts, "Should not retry an applied delete")
+}
+
+// TestLWTDeleteTimeoutRowRemains checks a timed out delete is retried when the row is still there with the
+// expected values
+func TestLWTDeleteTimeoutRowRemains(t *testing.T) {
+	// Arrange
+	session := &stubLWTSession{
+		timeouts: 1,
+		row:      &lwtRecord{ID: "a", Value: "original"},
+	}
+
+	// Act
+	applied, err := session.resolver().exec(context.Background(), "delete", session.execCAS, lwtExpectation{
+		keys:     []any{"a"},
+		expected: map[string]any{"value": "original"},
+		deletes:  true,
+	})
+
+	// Assert
+	require.NoError(t, err, "Should not error")
+	require.True(t, applied, "Should apply on retry")
+	require.Equal(t, 2, session.attempts, "Should have retried once")
+}
+
+// TestLWTDeleteTimeoutRowChanged checks a timed out delete returns ErrOutcomeUnknown when the row no
+// longer holds the expected values
+func TestLWTDeleteTimeoutRowChanged(t *testing.T) {
+	// Arrange
+	session := &stubLWTSession{
+		timeouts: 1,
+		row:      &lwtRecord{ID: "a", Value: "someone else"},
+	}
+
+	// Act
+	applied, err := session.resolver().exec(context.Background(), "delete", session.execCAS, lwtExpectation{
+		keys:     []any{"a"},
+		expected: map[string]any{"value": "original"},
+		deletes:  true,
+	})
+
+	// Assert
+	require.ErrorIs(t, err, ErrOutcomeUnknown, "Should report the outcome as unknown")
+	require.False(t, applied, "Should not report the delete as applied")
+	require.Equal(t, 1, session.attempts, "Should not retry an unknown outcome")
+}
+
+// TestEqualityColumn checks only equality conditions give an expected value for a conditional delete
+func TestEqualityColumn(t *testing.T) {
+	// Act
+	column, okEq := equalityColumn(qb.Eq("value"))
+	_, okGt := equalityColumn(qb.Gt("value"))
+	_, okNamed := equalityColumn(qb.EqNamed("value", "other"))
+
+	// Assert
+	require.True(t, okEq, "Should recognise an equality condition")
+	require.Equal(t, "value", column, "Should get the compared column")
+	require.False(t, okGt, "Should not treat other comparisons as equality")
+	require.False(t, okNamed, "Should not treat a differently named binding as the column's value")
+}
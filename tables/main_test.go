@@ -1,9 +1,12 @@
 package tables_test
 
 import (
+	"context"
+	"fmt"
 	"slices"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/gocql/gocql"
 	"github.com/scylladb/gocqlx/v3"
@@ -53,6 +56,8 @@ var testTableDeclarations = []string{
 	"create table charybdis_tests.orders (order_id varchar, shipping_address address, primary key(order_id))",
 	"create table charybdis_tests.order_items (order_id varchar, item_id varchar, quantity int, primary key((order_id), item_id))",
 	"CREATE INDEX order_item_lookup ON charybdis_tests.order_items (item_id)",
+	"create table charybdis_tests.market_selections (market_id varchar, selection_id varchar, market_name text static, price double, primary key((market_id), selection_id))",
+	"create table charybdis_tests.order_events (order_id varchar, occurred_at timestamp, status text, primary key((order_id), occurred_at))",
 	"CREATE MATERIALIZED VIEW charybdis_tests.item_orders AS SELECT * FROM charybdis_tests.order_items WHERE order_id IS NOT NULL AND item_id IS NOT NULL AND (quantity > 0) PRIMARY KEY((item_id), order_id, quantity) WITH CLUSTERING ORDER BY (order_id ASC)",
 }
 
@@ -157,6 +162,85 @@ var (
 	}
 )
 
+// Market selections table, with a static market header
+var (
+	marketSelectionColumns = []*metadata.ColumnSpecification{
+		{
+			Name:              "market_id",
+			CQLType:           "varchar",
+			IsPartitioningKey: true,
+		},
+		{
+			Name:            "selection_id",
+			CQLType:         "varchar",
+			IsClusteringKey: true,
+		},
+		{
+			Name:     "market_name",
+			CQLType:  "text",
+			IsStatic: true,
+		},
+		{
+			Name:    "price",
+			CQLType: "double",
+		},
+	}
+
+	MarketSelectionsTableSpec = &metadata.TableSpecification{
+		Name:    "market_selections",
+		Columns: slices.Clone(marketSelectionColumns),
+		Partitioning: []*metadata.PartitioningColumn{
+			{
+				Column: marketSelectionColumns[0],
+				Order:  1,
+			},
+		},
+		Clustering: []*metadata.ClusteringColumn{
+			{
+				Column: marketSelectionColumns[1],
+				Order:  1,
+			},
+		},
+	}
+)
+
+// Order Events table
+var (
+	orderEventColumns = []*metadata.ColumnSpecification{
+		{
+			Name:              "order_id",
+			CQLType:           "varchar",
+			IsPartitioningKey: true,
+		},
+		{
+			Name:            "occurred_at",
+			CQLType:         "timestamp",
+			IsClusteringKey: true,
+		},
+		{
+			Name:    "status",
+			CQLType: "text",
+		},
+	}
+
+	OrderEventsTableSpec = &metadata.TableSpecification{
+		Name:    "order_events",
+		Columns: slices.Clone(orderEventColumns),
+		Partitioning: []*metadata.PartitioningColumn{
+			{
+				Column: orderEventColumns[0],
+				Order:  1,
+			},
+		},
+		Clustering: []*metadata.ClusteringColumn{
+			{
+				Column: orderEventColumns[1],
+				Order:  1,
+			},
+		},
+	}
+)
+
 // Address type
 var (
 	addressFields = []*metadata.FieldSpecification{
@@ -197,6 +281,49 @@ type OrderItem struct {
 	Quantity int    `cql:"quantity"`
 }
 
+type OrderEvent struct {
+	OrderID    string    `cql:"order_id"`
+	OccurredAt time.Time `cql:"occurred_at"`
+	Status     string    `cql:"status"`
+}
+
+// CheckedItem is an order item implementing the record lifecycle interfaces
+type CheckedItem struct {
+	OrderID  string `cql:"order_id"`
+	ItemID   string `cql:"item_id"`
+	Quantity int    `cql:"quantity"`
+	loaded   bool
+}
+
+// BeforeInsert defaults the quantity of new items
+func (c *CheckedItem) BeforeInsert(ctx context.Context) error {
+	if c.Quantity == 0 {
+		c.Quantity = 1
+	}
+	return nil
+}
+
+// Validate rejects negative quantities
+func (c *CheckedItem) Validate() error {
+	if c.Quantity < 0 {
+		return fmt.Errorf("quantity %d is negative", c.Quantity)
+	}
+	return nil
+}
+
+// AfterLoad marks the item as read
+func (c *CheckedItem) AfterLoad(ctx context.Context) error {
+	c.loaded = true
+	return nil
+}
+
+type MarketSelection struct {
+	MarketID    string  `cql:"market_id"`
+	SelectionID string  `cql:"selection_id"`
+	MarketName  string  `cql:"market_name"`
+	Price       float64 `cql:"price"`
+}
+
 func testAddress(number int, street, city string) Address {
 	return Address{
 		Number: strconv.FormatInt(int64(number), 10),
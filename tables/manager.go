@@ -1,7 +1,7 @@
 package tables
 
 import (
-	"time"
+	"context"
 
 	"github.com/gocql/gocql"
 	"github.com/scylladb/gocqlx/v3"
@@ -10,8 +10,13 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
+
+	"github.com/zeroflucs-given/charybdis/metadata"
 )
 
+// schemaVerifyFn compares the live schema of a manager's table or view with its specification
+type schemaVerifyFn func(ctx context.Context, sess gocqlx.Session) ([]SchemaProblem, error)
+
 // baseManagerImpl is our underlying base manager implementation type, common to views and tables
 type baseManagerImpl[T any] struct {
 	Name            string               // Name of object
@@ -24,11 +29,21 @@ type baseManagerImpl[T any] struct {
 	TableMetadata   table.Metadata       // Table metadata
 
 	// Helper data
-	readConsistency        gocql.Consistency // Read consistency
-	qualifiedTableName     string            // Qualified table-name
-	allColumnNames         []string          // Set of all column names
-	nonKeyColumns          []string          // Non-key column names
-	partitionKeyPredicates []qb.Cmp          // Partition key predicates
-	allKeyPredicates       []qb.Cmp          // All key predicates, including partition key, in order
-	queryTimeout           time.Duration     // Timout for queries - copied through from the Session settings
+	readConsistency        gocql.Consistency                       // Read consistency
+	qualifiedTableName     string                                  // Qualified table-name
+	allColumnNames         []string                                // Set of all column names
+	nonKeyColumns          []string                                // Non-key column names
+	partitionKeyPredicates []qb.Cmp                                // Partition key predicates
+	allKeyPredicates       []qb.Cmp                                // All key predicates, including partition key, in order
+	clustering             []*metadata.ClusteringColumn            // Clustering columns, in order
+	keys                   keyEncoder                              // Builds comparable keys from primary key values
+	scanBypassCache        bool                                    // Scans bypass the Scylla cache
+	timeouts               operationTimeouts                       // Budgets for each kind of operation
+	startup                *startupState                           // Progress of startup, which must complete before the session is used
+	verifySchema           schemaVerifyFn                          // Compares the live schema with our specification, if configured
+	queries                map[string]*metadata.ParameterizedQuery // Named queries from the specification
+	faults                 FaultInjector                           // Fails or delays statements, for testing
+	coalescer              *readCoalescer                          // Shares identical concurrent reads, if enabled
+	cache                  *recordCache[T]                         // Caches reads by key, if enabled
+	lifecycle              recordLifecycle[T]                      // Lifecycle interfaces implemented by records
 }
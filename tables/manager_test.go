@@ -2,9 +2,12 @@ package tables_test
 
 import (
 	"context"
+	"errors"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/scylladb/gocqlx/v3"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zaptest"
 
@@ -47,6 +50,134 @@ func TestTableAndViewManager(t *testing.T) {
 	require.Equal(t, 1337, fetched.Quantity, "Should have non-key fields set")
 }
 
+// TestLazyStartup checks a manager is returned while startup is failing, and becomes ready once it succeeds
+func TestLazyStartup(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+	var available atomic.Bool
+
+	manager, err := tables.NewTableManager[Order](ctx,
+		tables.WithCluster(testClusterConfig),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(OrdersTableSpec),
+		tables.WithLazyStartup(),
+		tables.WithStartupRetry(tables.StartupRetryPolicy{InitialBackoff: 10 * time.Millisecond, MaxBackoff: 50 * time.Millisecond}),
+		tables.WithStartupFnEx(func(ctx context.Context, keyspace string, options ...tables.StartupOption) error {
+			if !available.Load() {
+				return errors.New("database unavailable")
+			}
+			return nil
+		}))
+	require.NoError(t, err, "Should not error starting up")
+
+	// Act
+	shortCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	errUnready := manager.Ready(shortCtx)
+	_, errGetUnready := manager.GetByPartitionKey(shortCtx, "lazy-test-1")
+
+	available.Store(true)
+	readyCtx, cancelReady := context.WithTimeout(ctx, 10*time.Second)
+	defer cancelReady()
+	errReady := manager.Ready(readyCtx)
+	errInsert := manager.Insert(ctx, &Order{OrderID: "lazy-test-1"})
+
+	// Assert
+	require.ErrorIs(t, errUnready, tables.ErrNotReady, "Should not be ready while startup fails")
+	require.ErrorIs(t, errGetUnready, tables.ErrNotReady, "Operations should fail when not ready")
+	require.NoError(t, errReady, "Should become ready once startup succeeds")
+	require.NoError(t, errInsert, "Should be usable once ready")
+}
+
+// TestLazyStartupGivesUp checks operations fail fast once startup has given up
+func TestLazyStartupGivesUp(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+	manager, err := tables.NewTableManager[Order](ctx,
+		tables.WithCluster(testClusterConfig),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(OrdersTableSpec),
+		tables.WithLazyStartup(),
+		tables.WithStartupRetry(tables.StartupRetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond}),
+		tables.WithStartupFnEx(func(ctx context.Context, keyspace string, options ...tables.StartupOption) error {
+			return errors.New("database unavailable")
+		}))
+	require.NoError(t, err, "Should not error starting up")
+
+	// Act
+	errReady := manager.Ready(ctx)
+	_, errGet := manager.GetByPartitionKey(ctx, "lazy-test-2")
+
+	// Assert
+	require.ErrorIs(t, errReady, tables.ErrNotReady, "Should report startup failure")
+	require.ErrorIs(t, errGet, tables.ErrNotReady, "Operations should fail fast")
+}
+
+// TestSessionPool checks managers share pooled sessions, and the session is closed with the last manager
+func TestSessionPool(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+	logger := zaptest.NewLogger(t)
+	pool := tables.NewSessionPool(testClusterConfig)
+	defer pool.Close()
+
+	orders, err := tables.NewTableManager[Order](ctx,
+		tables.WithSessionPool(pool),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(OrdersTableSpec),
+		generator.WithAutomaticTableManagement(logger, testClusterConfig))
+	require.NoError(t, err, "Should not error starting up")
+
+	items, err := tables.NewTableManager[OrderItem](ctx,
+		tables.WithSessionPool(pool),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(OrderItemsTableSpec),
+		generator.WithAutomaticTableManagement(logger, testClusterConfig))
+	require.NoError(t, err, "Should not error starting up")
+
+	ordersSession := orders.GetSession().(gocqlx.Session)
+	itemsSession := items.GetSession().(gocqlx.Session)
+	require.Same(t, ordersSession.Session, itemsSession.Session, "Managers should share a session")
+
+	// Act
+	orders.Close()
+	_, errClosed := orders.GetByPartitionKey(ctx, "pool-test-1")
+	errInsert := items.Insert(ctx, &OrderItem{OrderID: "pool-test-1", ItemID: "item-1", Quantity: 1})
+	openAfterFirst := !itemsSession.Session.Closed()
+	items.Close()
+
+	// Assert
+	require.ErrorIs(t, errClosed, tables.ErrManagerClosed, "Closed manager should not be usable")
+	require.NoError(t, errInsert, "Other manager should still work")
+	require.True(t, openAfterFirst, "Session should stay open while a manager uses it")
+	require.True(t, itemsSession.Session.Closed(), "Session should close with the last manager")
+}
+
+// TestWithSession checks a manager can use a session it is given, and leaves it open when closed
+func TestWithSession(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+	cluster := testClusterConfig()
+	cluster.Keyspace = TestKeyspace
+	session, errSession := gocqlx.WrapSession(cluster.CreateSession())
+	require.NoError(t, errSession, "Should not error creating session")
+	defer session.Close()
+
+	manager, err := tables.NewTableManager[Order](ctx,
+		tables.WithSession(session),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(OrdersTableSpec))
+	require.NoError(t, err, "Should not error starting up")
+
+	// Act
+	errInsert := manager.Insert(ctx, &Order{OrderID: "session-test-1"})
+	manager.Close()
+
+	// Assert
+	require.NoError(t, errInsert, "Should not error inserting")
+	require.False(t, session.Closed(), "Session should be left open")
+}
+
 func TestRolesAndGrants(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -12,6 +12,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gocqlx "github.com/scylladb/gocqlx/v3"
 	qb "github.com/scylladb/gocqlx/v3/qb"
@@ -80,6 +81,51 @@ func (mr *MockTableManagerMockRecorder[T]) AddPreDeleteHook(hook any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPreDeleteHook", reflect.TypeOf((*MockTableManager[T])(nil).AddPreDeleteHook), hook)
 }
 
+// CacheStats mocks base method.
+func (m *MockTableManager[T]) CacheStats() tables.CacheStats {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CacheStats")
+	ret0, _ := ret[0].(tables.CacheStats)
+	return ret0
+}
+
+// CacheStats indicates an expected call of CacheStats.
+func (mr *MockTableManagerMockRecorder[T]) CacheStats() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CacheStats", reflect.TypeOf((*MockTableManager[T])(nil).CacheStats))
+}
+
+// Close mocks base method.
+func (m *MockTableManager[T]) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockTableManagerMockRecorder[T]) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockTableManager[T])(nil).Close))
+}
+
+// CompareAndSwap mocks base method.
+func (m *MockTableManager[T]) CompareAndSwap(ctx context.Context, expected, replacement *T, columns ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, expected, replacement}
+	for _, a := range columns {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CompareAndSwap", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompareAndSwap indicates an expected call of CompareAndSwap.
+func (mr *MockTableManagerMockRecorder[T]) CompareAndSwap(ctx, expected, replacement any, columns ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, expected, replacement}, columns...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompareAndSwap", reflect.TypeOf((*MockTableManager[T])(nil).CompareAndSwap), varargs...)
+}
+
 // Count mocks base method.
 func (m *MockTableManager[T]) Count(ctx context.Context) (int64, error) {
 	m.ctrl.T.Helper()
@@ -144,6 +190,26 @@ func (mr *MockTableManagerMockRecorder[T]) Delete(ctx, instance any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTableManager[T])(nil).Delete), ctx, instance)
 }
 
+// DeleteAndReturn mocks base method.
+func (m *MockTableManager[T]) DeleteAndReturn(ctx context.Context, opts []tables.DeleteOption, primaryKeys ...any) (*T, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, opts}
+	for _, a := range primaryKeys {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteAndReturn", varargs...)
+	ret0, _ := ret[0].(*T)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAndReturn indicates an expected call of DeleteAndReturn.
+func (mr *MockTableManagerMockRecorder[T]) DeleteAndReturn(ctx, opts any, primaryKeys ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, opts}, primaryKeys...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAndReturn", reflect.TypeOf((*MockTableManager[T])(nil).DeleteAndReturn), varargs...)
+}
+
 // DeleteByPrimaryKey mocks base method.
 func (m *MockTableManager[T]) DeleteByPrimaryKey(ctx context.Context, keys ...any) error {
 	m.ctrl.T.Helper()
@@ -163,6 +229,39 @@ func (mr *MockTableManagerMockRecorder[T]) DeleteByPrimaryKey(ctx any, keys ...a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByPrimaryKey", reflect.TypeOf((*MockTableManager[T])(nil).DeleteByPrimaryKey), varargs...)
 }
 
+// DeletePartition mocks base method.
+func (m *MockTableManager[T]) DeletePartition(ctx context.Context, opts []tables.DeleteOption, partitionKeys ...any) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, opts}
+	for _, a := range partitionKeys {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeletePartition", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePartition indicates an expected call of DeletePartition.
+func (mr *MockTableManagerMockRecorder[T]) DeletePartition(ctx, opts any, partitionKeys ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, opts}, partitionKeys...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePartition", reflect.TypeOf((*MockTableManager[T])(nil).DeletePartition), varargs...)
+}
+
+// DeleteRange mocks base method.
+func (m *MockTableManager[T]) DeleteRange(ctx context.Context, partitionKeys []any, r tables.Range) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRange", ctx, partitionKeys, r)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRange indicates an expected call of DeleteRange.
+func (mr *MockTableManagerMockRecorder[T]) DeleteRange(ctx, partitionKeys, r any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRange", reflect.TypeOf((*MockTableManager[T])(nil).DeleteRange), ctx, partitionKeys, r)
+}
+
 // DeleteUsingOptions mocks base method.
 func (m *MockTableManager[T]) DeleteUsingOptions(ctx context.Context, opts ...tables.DeleteOption) error {
 	m.ctrl.T.Helper()
@@ -242,6 +341,27 @@ func (mr *MockTableManagerMockRecorder[T]) GetByPrimaryKey(ctx any, primaryKeys
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByPrimaryKey", reflect.TypeOf((*MockTableManager[T])(nil).GetByPrimaryKey), varargs...)
 }
 
+// GetOrInsert mocks base method.
+func (m *MockTableManager[T]) GetOrInsert(ctx context.Context, instance *T, opts ...tables.InsertOption) (*T, bool, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, instance}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetOrInsert", varargs...)
+	ret0, _ := ret[0].(*T)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOrInsert indicates an expected call of GetOrInsert.
+func (mr *MockTableManagerMockRecorder[T]) GetOrInsert(ctx, instance any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, instance}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrInsert", reflect.TypeOf((*MockTableManager[T])(nil).GetOrInsert), varargs...)
+}
+
 // GetSession mocks base method.
 func (m *MockTableManager[T]) GetSession() any {
 	m.ctrl.T.Helper()
@@ -256,6 +376,26 @@ func (mr *MockTableManagerMockRecorder[T]) GetSession() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockTableManager[T])(nil).GetSession))
 }
 
+// GetStatic mocks base method.
+func (m *MockTableManager[T]) GetStatic(ctx context.Context, partitionKeys ...any) (*T, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range partitionKeys {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetStatic", varargs...)
+	ret0, _ := ret[0].(*T)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatic indicates an expected call of GetStatic.
+func (mr *MockTableManagerMockRecorder[T]) GetStatic(ctx any, partitionKeys ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, partitionKeys...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatic", reflect.TypeOf((*MockTableManager[T])(nil).GetStatic), varargs...)
+}
+
 // GetTableSpec mocks base method.
 func (m *MockTableManager[T]) GetTableSpec() *metadata.TableSpecification {
 	m.ctrl.T.Helper()
@@ -347,6 +487,53 @@ func (mr *MockTableManagerMockRecorder[T]) InsertOrReplace(ctx, instance any, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertOrReplace", reflect.TypeOf((*MockTableManager[T])(nil).InsertOrReplace), varargs...)
 }
 
+// Ping mocks base method.
+func (m *MockTableManager[T]) Ping(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ping", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Ping indicates an expected call of Ping.
+func (mr *MockTableManagerMockRecorder[T]) Ping(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockTableManager[T])(nil).Ping), ctx)
+}
+
+// Query mocks base method.
+func (m *MockTableManager[T]) Query(ctx context.Context, name string, fn tables.PageHandlerFn[T], params map[string]any, opts ...tables.QueryOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name, fn, params}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Query", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Query indicates an expected call of Query.
+func (mr *MockTableManagerMockRecorder[T]) Query(ctx, name, fn, params any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name, fn, params}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Query", reflect.TypeOf((*MockTableManager[T])(nil).Query), varargs...)
+}
+
+// Ready mocks base method.
+func (m *MockTableManager[T]) Ready(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ready", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Ready indicates an expected call of Ready.
+func (mr *MockTableManagerMockRecorder[T]) Ready(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ready", reflect.TypeOf((*MockTableManager[T])(nil).Ready), ctx)
+}
+
 // Scan mocks base method.
 func (m *MockTableManager[T]) Scan(ctx context.Context, fn tables.PageHandlerFn[T], opts ...tables.QueryOption) error {
 	m.ctrl.T.Helper()
@@ -442,6 +629,25 @@ func (mr *MockTableManagerMockRecorder[T]) SelectByPrimaryKey(ctx, fn, opts any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectByPrimaryKey", reflect.TypeOf((*MockTableManager[T])(nil).SelectByPrimaryKey), varargs...)
 }
 
+// SelectRange mocks base method.
+func (m *MockTableManager[T]) SelectRange(ctx context.Context, fn tables.PageHandlerFn[T], partitionKeys []any, r tables.Range, opts ...tables.QueryOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, fn, partitionKeys, r}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SelectRange", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SelectRange indicates an expected call of SelectRange.
+func (mr *MockTableManagerMockRecorder[T]) SelectRange(ctx, fn, partitionKeys, r any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, fn, partitionKeys, r}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectRange", reflect.TypeOf((*MockTableManager[T])(nil).SelectRange), varargs...)
+}
+
 // Truncate mocks base method.
 func (m *MockTableManager[T]) Truncate(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -475,6 +681,25 @@ func (mr *MockTableManagerMockRecorder[T]) Update(ctx, instance any, opts ...any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTableManager[T])(nil).Update), varargs...)
 }
 
+// UpdateFunc mocks base method.
+func (m *MockTableManager[T]) UpdateFunc(ctx context.Context, keys []any, fn func(*T) (*T, error), opts ...tables.UpdateFuncOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, keys, fn}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateFunc", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateFunc indicates an expected call of UpdateFunc.
+func (mr *MockTableManagerMockRecorder[T]) UpdateFunc(ctx, keys, fn any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, keys, fn}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFunc", reflect.TypeOf((*MockTableManager[T])(nil).UpdateFunc), varargs...)
+}
+
 // Upsert mocks base method.
 func (m *MockTableManager[T]) Upsert(ctx context.Context, instance *T, opts ...tables.UpsertOption) error {
 	m.ctrl.T.Helper()
@@ -513,6 +738,39 @@ func (mr *MockTableManagerMockRecorder[T]) UpsertBulk(ctx, instances, concurrenc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertBulk", reflect.TypeOf((*MockTableManager[T])(nil).UpsertBulk), varargs...)
 }
 
+// UpsertStatic mocks base method.
+func (m *MockTableManager[T]) UpsertStatic(ctx context.Context, instance *T, opts ...tables.UpsertOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, instance}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpsertStatic", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertStatic indicates an expected call of UpsertStatic.
+func (mr *MockTableManagerMockRecorder[T]) UpsertStatic(ctx, instance any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, instance}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertStatic", reflect.TypeOf((*MockTableManager[T])(nil).UpsertStatic), varargs...)
+}
+
+// Verify mocks base method.
+func (m *MockTableManager[T]) Verify(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verify", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Verify indicates an expected call of Verify.
+func (mr *MockTableManagerMockRecorder[T]) Verify(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockTableManager[T])(nil).Verify), ctx)
+}
+
 // MockViewManager is a mock of ViewManager interface.
 type MockViewManager[T any] struct {
 	ctrl     *gomock.Controller
@@ -537,6 +795,32 @@ func (m *MockViewManager[T]) EXPECT() *MockViewManagerMockRecorder[T] {
 	return m.recorder
 }
 
+// CacheStats mocks base method.
+func (m *MockViewManager[T]) CacheStats() tables.CacheStats {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CacheStats")
+	ret0, _ := ret[0].(tables.CacheStats)
+	return ret0
+}
+
+// CacheStats indicates an expected call of CacheStats.
+func (mr *MockViewManagerMockRecorder[T]) CacheStats() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CacheStats", reflect.TypeOf((*MockViewManager[T])(nil).CacheStats))
+}
+
+// Close mocks base method.
+func (m *MockViewManager[T]) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockViewManagerMockRecorder[T]) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockViewManager[T])(nil).Close))
+}
+
 // CountByCustomQuery mocks base method.
 func (m *MockViewManager[T]) CountByCustomQuery(ctx context.Context, queryBuilder tables.QueryBuilderFn) (int64, error) {
 	m.ctrl.T.Helper()
@@ -652,6 +936,53 @@ func (mr *MockViewManagerMockRecorder[T]) GetUsingOptions(ctx any, opts ...any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsingOptions", reflect.TypeOf((*MockViewManager[T])(nil).GetUsingOptions), varargs...)
 }
 
+// Ping mocks base method.
+func (m *MockViewManager[T]) Ping(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ping", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Ping indicates an expected call of Ping.
+func (mr *MockViewManagerMockRecorder[T]) Ping(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockViewManager[T])(nil).Ping), ctx)
+}
+
+// Query mocks base method.
+func (m *MockViewManager[T]) Query(ctx context.Context, name string, fn tables.PageHandlerFn[T], params map[string]any, opts ...tables.QueryOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name, fn, params}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Query", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Query indicates an expected call of Query.
+func (mr *MockViewManagerMockRecorder[T]) Query(ctx, name, fn, params any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name, fn, params}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Query", reflect.TypeOf((*MockViewManager[T])(nil).Query), varargs...)
+}
+
+// Ready mocks base method.
+func (m *MockViewManager[T]) Ready(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ready", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Ready indicates an expected call of Ready.
+func (mr *MockViewManagerMockRecorder[T]) Ready(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ready", reflect.TypeOf((*MockViewManager[T])(nil).Ready), ctx)
+}
+
 // Scan mocks base method.
 func (m *MockViewManager[T]) Scan(ctx context.Context, fn tables.PageHandlerFn[T], opts ...tables.QueryOption) error {
 	m.ctrl.T.Helper()
@@ -747,6 +1078,39 @@ func (mr *MockViewManagerMockRecorder[T]) SelectByPrimaryKey(ctx, fn, opts any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectByPrimaryKey", reflect.TypeOf((*MockViewManager[T])(nil).SelectByPrimaryKey), varargs...)
 }
 
+// SelectRange mocks base method.
+func (m *MockViewManager[T]) SelectRange(ctx context.Context, fn tables.PageHandlerFn[T], partitionKeys []any, r tables.Range, opts ...tables.QueryOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, fn, partitionKeys, r}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SelectRange", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SelectRange indicates an expected call of SelectRange.
+func (mr *MockViewManagerMockRecorder[T]) SelectRange(ctx, fn, partitionKeys, r any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, fn, partitionKeys, r}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectRange", reflect.TypeOf((*MockViewManager[T])(nil).SelectRange), varargs...)
+}
+
+// Verify mocks base method.
+func (m *MockViewManager[T]) Verify(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verify", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Verify indicates an expected call of Verify.
+func (mr *MockViewManagerMockRecorder[T]) Verify(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockViewManager[T])(nil).Verify), ctx)
+}
+
 // MockInsertOption is a mock of InsertOption interface.
 type MockInsertOption struct {
 	ctrl     *gomock.Controller
@@ -799,6 +1163,34 @@ func (mr *MockInsertOptionMockRecorder) isPrecondition() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "isPrecondition", reflect.TypeOf((*MockInsertOption)(nil).isPrecondition))
 }
 
+// skipsNilColumns mocks base method.
+func (m *MockInsertOption) skipsNilColumns() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "skipsNilColumns")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// skipsNilColumns indicates an expected call of skipsNilColumns.
+func (mr *MockInsertOptionMockRecorder) skipsNilColumns() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "skipsNilColumns", reflect.TypeOf((*MockInsertOption)(nil).skipsNilColumns))
+}
+
+// timeoutBudget mocks base method.
+func (m *MockInsertOption) timeoutBudget() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "timeoutBudget")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// timeoutBudget indicates an expected call of timeoutBudget.
+func (mr *MockInsertOptionMockRecorder) timeoutBudget() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "timeoutBudget", reflect.TypeOf((*MockInsertOption)(nil).timeoutBudget))
+}
+
 // MockDeleteOption is a mock of DeleteOption interface.
 type MockDeleteOption struct {
 	ctrl     *gomock.Controller
@@ -823,18 +1215,18 @@ func (m *MockDeleteOption) EXPECT() *MockDeleteOptionMockRecorder {
 	return m.recorder
 }
 
-// applyToBuilder mocks base method.
-func (m *MockDeleteOption) applyToBuilder(builder *qb.DeleteBuilder) *qb.DeleteBuilder {
+// applyToDeleteBuilder mocks base method.
+func (m *MockDeleteOption) applyToDeleteBuilder(builder *qb.DeleteBuilder) *qb.DeleteBuilder {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "applyToBuilder", builder)
+	ret := m.ctrl.Call(m, "applyToDeleteBuilder", builder)
 	ret0, _ := ret[0].(*qb.DeleteBuilder)
 	return ret0
 }
 
-// applyToBuilder indicates an expected call of applyToBuilder.
-func (mr *MockDeleteOptionMockRecorder) applyToBuilder(builder any) *gomock.Call {
+// applyToDeleteBuilder indicates an expected call of applyToDeleteBuilder.
+func (mr *MockDeleteOptionMockRecorder) applyToDeleteBuilder(builder any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "applyToBuilder", reflect.TypeOf((*MockDeleteOption)(nil).applyToBuilder), builder)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "applyToDeleteBuilder", reflect.TypeOf((*MockDeleteOption)(nil).applyToDeleteBuilder), builder)
 }
 
 // applyToQuery mocks base method.
@@ -879,6 +1271,20 @@ func (mr *MockDeleteOptionMockRecorder) conditions() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "conditions", reflect.TypeOf((*MockDeleteOption)(nil).conditions))
 }
 
+// expectedValues mocks base method.
+func (m *MockDeleteOption) expectedValues() map[string]any {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "expectedValues")
+	ret0, _ := ret[0].(map[string]any)
+	return ret0
+}
+
+// expectedValues indicates an expected call of expectedValues.
+func (mr *MockDeleteOptionMockRecorder) expectedValues() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "expectedValues", reflect.TypeOf((*MockDeleteOption)(nil).expectedValues))
+}
+
 // isPrecondition mocks base method.
 func (m *MockDeleteOption) isPrecondition() bool {
 	m.ctrl.T.Helper()
@@ -893,6 +1299,20 @@ func (mr *MockDeleteOptionMockRecorder) isPrecondition() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "isPrecondition", reflect.TypeOf((*MockDeleteOption)(nil).isPrecondition))
 }
 
+// timeoutBudget mocks base method.
+func (m *MockDeleteOption) timeoutBudget() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "timeoutBudget")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// timeoutBudget indicates an expected call of timeoutBudget.
+func (mr *MockDeleteOptionMockRecorder) timeoutBudget() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "timeoutBudget", reflect.TypeOf((*MockDeleteOption)(nil).timeoutBudget))
+}
+
 // MockQueryOption is a mock of QueryOption interface.
 type MockQueryOption struct {
 	ctrl     *gomock.Controller
@@ -973,6 +1393,48 @@ func (mr *MockQueryOptionMockRecorder) columns() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "columns", reflect.TypeOf((*MockQueryOption)(nil).columns))
 }
 
+// pageTimingFn mocks base method.
+func (m *MockQueryOption) pageTimingFn() func(tables.PageTiming) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "pageTimingFn")
+	ret0, _ := ret[0].(func(tables.PageTiming))
+	return ret0
+}
+
+// pageTimingFn indicates an expected call of pageTimingFn.
+func (mr *MockQueryOptionMockRecorder) pageTimingFn() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "pageTimingFn", reflect.TypeOf((*MockQueryOption)(nil).pageTimingFn))
+}
+
+// prefetchPages mocks base method.
+func (m *MockQueryOption) prefetchPages() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "prefetchPages")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// prefetchPages indicates an expected call of prefetchPages.
+func (mr *MockQueryOptionMockRecorder) prefetchPages() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "prefetchPages", reflect.TypeOf((*MockQueryOption)(nil).prefetchPages))
+}
+
+// timeoutBudget mocks base method.
+func (m *MockQueryOption) timeoutBudget() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "timeoutBudget")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// timeoutBudget indicates an expected call of timeoutBudget.
+func (mr *MockQueryOptionMockRecorder) timeoutBudget() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "timeoutBudget", reflect.TypeOf((*MockQueryOption)(nil).timeoutBudget))
+}
+
 // MockUpdateOption is a mock of UpdateOption interface.
 type MockUpdateOption struct {
 	ctrl     *gomock.Controller
@@ -1039,6 +1501,34 @@ func (mr *MockUpdateOptionMockRecorder) isPrecondition() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "isPrecondition", reflect.TypeOf((*MockUpdateOption)(nil).isPrecondition))
 }
 
+// skipsNilColumns mocks base method.
+func (m *MockUpdateOption) skipsNilColumns() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "skipsNilColumns")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// skipsNilColumns indicates an expected call of skipsNilColumns.
+func (mr *MockUpdateOptionMockRecorder) skipsNilColumns() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "skipsNilColumns", reflect.TypeOf((*MockUpdateOption)(nil).skipsNilColumns))
+}
+
+// timeoutBudget mocks base method.
+func (m *MockUpdateOption) timeoutBudget() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "timeoutBudget")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// timeoutBudget indicates an expected call of timeoutBudget.
+func (mr *MockUpdateOptionMockRecorder) timeoutBudget() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "timeoutBudget", reflect.TypeOf((*MockUpdateOption)(nil).timeoutBudget))
+}
+
 // MockUpsertOption is a mock of UpsertOption interface.
 type MockUpsertOption struct {
 	ctrl     *gomock.Controller
@@ -1118,3 +1608,31 @@ func (mr *MockUpsertOptionMockRecorder) isPrecondition() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "isPrecondition", reflect.TypeOf((*MockUpsertOption)(nil).isPrecondition))
 }
+
+// skipsNilColumns mocks base method.
+func (m *MockUpsertOption) skipsNilColumns() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "skipsNilColumns")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// skipsNilColumns indicates an expected call of skipsNilColumns.
+func (mr *MockUpsertOptionMockRecorder) skipsNilColumns() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "skipsNilColumns", reflect.TypeOf((*MockUpsertOption)(nil).skipsNilColumns))
+}
+
+// timeoutBudget mocks base method.
+func (m *MockUpsertOption) timeoutBudget() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "timeoutBudget")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// timeoutBudget indicates an expected call of timeoutBudget.
+func (mr *MockUpsertOptionMockRecorder) timeoutBudget() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "timeoutBudget", reflect.TypeOf((*MockUpsertOption)(nil).timeoutBudget))
+}
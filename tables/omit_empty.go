@@ -0,0 +1,88 @@
+package tables
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/gocql/gocql"
+	"github.com/scylladb/gocqlx/v3"
+)
+
+const (
+	// columnTagName is the struct tag naming a column. This matches mapping.TagNameCassandra.
+	columnTagName = "cql"
+
+	// omitEmptyTagOption is the column tag option that leaves a column untouched on write
+	// when its value is nil or empty, i.e. `cql:"name,omitempty"`.
+	omitEmptyTagOption = "omitempty"
+)
+
+// findOmitEmptyColumns gets the set of columns on the record type that carry the omitempty
+// tag option. Only columns in the candidates list are considered.
+func findOmitEmptyColumns[T any](candidates []string) map[string]bool {
+	t := reflect.TypeFor[T]()
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	result := map[string]bool{}
+	for _, field := range reflect.VisibleFields(t) {
+		parts := strings.Split(field.Tag.Get(columnTagName), ",")
+		name := strings.TrimSpace(parts[0])
+		if name == "" || !slices.Contains(candidates, name) {
+			continue
+		}
+
+		for _, opt := range parts[1:] {
+			if strings.TrimSpace(opt) == omitEmptyTagOption {
+				result[name] = true
+			}
+		}
+	}
+
+	return result
+}
+
+// isNilOrEmpty determines if a value should be considered absent, being a nil pointer
+// or interface, or a nil or empty slice or map.
+func isNilOrEmpty(val any) bool {
+	if val == nil {
+		return true
+	}
+
+	v := reflect.ValueOf(val)
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		return v.IsNil()
+	case reflect.Slice, reflect.Map:
+		return v.Len() == 0
+	default:
+		return false
+	}
+}
+
+// unsetTransformer creates a bind transformer that binds gocql.UnsetValue for nil or empty
+// non-key columns, so that writes leave them untouched rather than writing tombstones. Columns
+// tagged omitempty are always considered, with all other non-key columns only considered if
+// skipAllNil is set. Returns nil if there is nothing to transform.
+func (t *tableManagerImpl[T]) unsetTransformer(skipAllNil bool) gocqlx.Transformer {
+	if !skipAllNil && len(t.omitEmptyColumns) == 0 {
+		return nil
+	}
+
+	return func(name string, val any) any {
+		if skipAllNil {
+			if !slices.Contains(t.nonKeyColumns, name) {
+				return val
+			}
+		} else if !t.omitEmptyColumns[name] {
+			return val
+		}
+
+		if isNilOrEmpty(val) {
+			return gocql.UnsetValue
+		}
+		return val
+	}
+}
@@ -0,0 +1,48 @@
+package tables
+
+import "time"
+
+// AdaptiveOption is an option that changes how an AdaptiveLimiter adjusts its limit
+type AdaptiveOption func(params *adaptiveParameters)
+
+// adaptiveParameters are the parameters of an AdaptiveLimiter
+type adaptiveParameters struct {
+	initialLimit     int             // Limit to start from
+	minLimit         int             // Lowest the limit may fall to
+	maxLimit         int             // Highest the limit may rise to
+	backoffRatio     float64         // Multiplier applied to the limit when overloaded
+	latencyTolerance float64         // Multiple of the baseline latency treated as overload
+	minSampleLatency time.Duration   // Latencies below this are never treated as overload
+	limitFn          func(limit int) // Receives the limit whenever it changes
+}
+
+// WithAdaptiveLimits sets the initial, lowest and highest concurrency limits
+func WithAdaptiveLimits(initial int, minimum int, maximum int) AdaptiveOption {
+	return func(params *adaptiveParameters) {
+		params.initialLimit = initial
+		params.minLimit = minimum
+		params.maxLimit = maximum
+	}
+}
+
+// WithAdaptiveBackoff sets the ratio, between 0 and 1, the limit is multiplied by when overload is detected
+func WithAdaptiveBackoff(ratio float64) AdaptiveOption {
+	return func(params *adaptiveParameters) {
+		params.backoffRatio = ratio
+	}
+}
+
+// WithAdaptiveLatencyTolerance sets how many times the baseline latency an operation may take before
+// it is treated as a sign of overload
+func WithAdaptiveLatencyTolerance(ratio float64) AdaptiveOption {
+	return func(params *adaptiveParameters) {
+		params.latencyTolerance = ratio
+	}
+}
+
+// WithAdaptiveLimitObserver sets a function that receives the limit whenever it changes, for logging or metrics
+func WithAdaptiveLimitObserver(fn func(limit int)) AdaptiveOption {
+	return func(params *adaptiveParameters) {
+		params.limitFn = fn
+	}
+}
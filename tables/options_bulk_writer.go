@@ -0,0 +1,68 @@
+package tables
+
+import "time"
+
+// BulkWriterOption is an option that changes how a BulkWriter buffers and flushes rows
+type BulkWriterOption[T any] func(params *bulkWriterParameters[T])
+
+// bulkWriterParameters are the parameters of a BulkWriter
+type bulkWriterParameters[T any] struct {
+	bufferSize    int                        // Rows that can be queued before Write blocks
+	flushSize     int                        // Rows that trigger a flush
+	flushInterval time.Duration              // Longest time a row waits before being flushed
+	batchSize     int                        // Most rows written in a single batch
+	concurrency   int                        // Batches written at once during a flush
+	failureFn     func(rows []*T, err error) // Receives rows that could not be written
+	upsertOpts    []UpsertOption             // Options applied to every write
+}
+
+// WithBulkWriterBufferSize sets the number of rows that can be queued before Write blocks
+func WithBulkWriterBufferSize[T any](size int) BulkWriterOption[T] {
+	return func(params *bulkWriterParameters[T]) {
+		params.bufferSize = size
+	}
+}
+
+// WithBulkWriterFlushSize sets the number of queued rows that causes a flush
+func WithBulkWriterFlushSize[T any](size int) BulkWriterOption[T] {
+	return func(params *bulkWriterParameters[T]) {
+		params.flushSize = size
+	}
+}
+
+// WithBulkWriterFlushInterval sets the longest time a row is held before being flushed
+func WithBulkWriterFlushInterval[T any](interval time.Duration) BulkWriterOption[T] {
+	return func(params *bulkWriterParameters[T]) {
+		params.flushInterval = interval
+	}
+}
+
+// WithBulkWriterBatchSize sets the most rows for a single partition written in one unlogged batch
+func WithBulkWriterBatchSize[T any](size int) BulkWriterOption[T] {
+	return func(params *bulkWriterParameters[T]) {
+		params.batchSize = size
+	}
+}
+
+// WithBulkWriterConcurrency sets the number of batches written at once during a flush
+func WithBulkWriterConcurrency[T any](concurrency int) BulkWriterOption[T] {
+	return func(params *bulkWriterParameters[T]) {
+		params.concurrency = concurrency
+	}
+}
+
+// WithBulkWriterFailureHandler sets a function that receives rows that could not be written,
+// along with the error that caused the failure
+func WithBulkWriterFailureHandler[T any](fn func(rows []*T, err error)) BulkWriterOption[T] {
+	return func(params *bulkWriterParameters[T]) {
+		params.failureFn = fn
+	}
+}
+
+// WithBulkWriterUpsertOptions sets options applied to every write, such as a TTL. Options that add
+// preconditions are not supported.
+func WithBulkWriterUpsertOptions[T any](opts ...UpsertOption) BulkWriterOption[T] {
+	return func(params *bulkWriterParameters[T]) {
+		params.upsertOpts = append(params.upsertOpts, opts...)
+	}
+}
@@ -1,6 +1,7 @@
 package tables
 
 import (
+	"reflect"
 	"slices"
 	"time"
 
@@ -14,11 +15,13 @@ type deleteOption struct {
 	builderFn      func(builder *qb.DeleteBuilder) *qb.DeleteBuilder
 	predicates     []qb.Cmp
 	targetBindings []any
+	expected       map[string]any // Column values a precondition requires, if known
 	isLWT          bool
+	budget         time.Duration
 }
 
-// deleteOption applies this option to the given delete builder
-func (s *deleteOption) applyToBuilder(builder *qb.DeleteBuilder) *qb.DeleteBuilder {
+// applyToDeleteBuilder applies this option to the given delete builder
+func (s *deleteOption) applyToDeleteBuilder(builder *qb.DeleteBuilder) *qb.DeleteBuilder {
 	if s.builderFn == nil {
 		return builder
 	}
@@ -41,10 +44,18 @@ func (s *deleteOption) bindings() []any {
 	return s.targetBindings
 }
 
+func (s *deleteOption) expectedValues() map[string]any {
+	return s.expected
+}
+
 func (s *deleteOption) isPrecondition() bool {
 	return s.isLWT
 }
 
+func (s *deleteOption) timeoutBudget() time.Duration {
+	return s.budget
+}
+
 // DeleteColumns specifies the columns to delete from matched rows
 func DeleteColumns(columns ...string) DeleteOption {
 	return &deleteOption{
@@ -96,26 +107,45 @@ func WithDeletionCondition(cond qb.Cmp, value any) DeleteOption {
 	}
 }
 
+// WithDeleteIf makes the delete conditional on `cond` holding for the given value
 func WithDeleteIf(cond qb.Cmp, value any) DeleteOption {
+	var expected map[string]any
+	if column, ok := equalityColumn(cond); ok {
+		expected = map[string]any{column: value}
+	}
+
 	return &deleteOption{
 		builderFn: func(builder *qb.DeleteBuilder) *qb.DeleteBuilder {
 			return builder.If(cond)
 		},
 		targetBindings: []any{value},
 		predicates:     []qb.Cmp{cond},
+		expected:       expected,
 		isLWT:          true,
 	}
 }
 
+// WithDeleteIfExists makes the delete conditional on the row existing
 func WithDeleteIfExists() DeleteOption {
 	return &deleteOption{
 		builderFn: func(builder *qb.DeleteBuilder) *qb.DeleteBuilder {
 			return builder.Existing()
 		},
-		isLWT: true,
+		expected: map[string]any{},
+		isLWT:    true,
 	}
 }
 
+// equalityColumn gets the column a condition compares for equality with its own named binding, which
+// is the form produced by qb.Eq
+func equalityColumn(cond qb.Cmp) (string, bool) {
+	_, names := qb.Delete("").If(cond).ToCql()
+	if len(names) != 1 || !reflect.DeepEqual(cond, qb.Eq(names[0])) {
+		return "", false
+	}
+	return names[0], true
+}
+
 func WithDeleteUsingTimestamp(ts int64) DeleteOption {
 	return &deleteOption{
 		builderFn: func(builder *qb.DeleteBuilder) *qb.DeleteBuilder {
@@ -123,3 +153,10 @@ func WithDeleteUsingTimestamp(ts int64) DeleteOption {
 		},
 	}
 }
+
+// WithDeleteTimeout overrides the manager's budget for this delete, including any retries
+func WithDeleteTimeout(d time.Duration) DeleteOption {
+	return &deleteOption{
+		budget: d,
+	}
+}
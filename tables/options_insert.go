@@ -9,10 +9,15 @@ import (
 type insertOption struct {
 	insertBuilderFn   func(builder *qb.InsertBuilder) *qb.InsertBuilder
 	isOptPrecondition bool
+	skipNil           bool
+	budget            time.Duration
 }
 
 // Apply applies the update optionInsertBuilder
 func (u *insertOption) applyToInsertBuilder(builder *qb.InsertBuilder) *qb.InsertBuilder {
+	if u.insertBuilderFn == nil {
+		return builder
+	}
 	return u.insertBuilderFn(builder)
 }
 
@@ -20,6 +25,14 @@ func (u *insertOption) isPrecondition() bool {
 	return u.isOptPrecondition
 }
 
+func (u *insertOption) skipsNilColumns() bool {
+	return u.skipNil
+}
+
+func (u *insertOption) timeoutBudget() time.Duration {
+	return u.budget
+}
+
 // WithNotExists sets IF NOT EXISTS on the query to ensure an insert is a new record.
 func WithNotExists() InsertOption {
 	return &insertOption{
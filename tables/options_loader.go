@@ -0,0 +1,35 @@
+package tables
+
+import "time"
+
+// LoaderOption is an option that changes how a Loader batches lookups
+type LoaderOption func(params *loaderParameters)
+
+// loaderParameters are the parameters of a Loader
+type loaderParameters struct {
+	wait        time.Duration // How long to collect lookups before dispatching a batch
+	maxBatch    int           // Number of distinct keys that triggers an immediate dispatch
+	concurrency int           // Number of queries a batch may run at once
+}
+
+// WithLoaderWait sets how long a Loader collects lookups before dispatching them as a batch
+func WithLoaderWait(wait time.Duration) LoaderOption {
+	return func(params *loaderParameters) {
+		params.wait = wait
+	}
+}
+
+// WithLoaderMaxBatch sets the number of distinct keys that causes a batch to be dispatched without
+// waiting for the rest of the window
+func WithLoaderMaxBatch(size int) LoaderOption {
+	return func(params *loaderParameters) {
+		params.maxBatch = size
+	}
+}
+
+// WithLoaderConcurrency sets the number of queries a single batch may run at once
+func WithLoaderConcurrency(concurrency int) LoaderOption {
+	return func(params *loaderParameters) {
+		params.concurrency = concurrency
+	}
+}
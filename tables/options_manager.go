@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/gocql/gocql"
+	"github.com/scylladb/gocqlx/v3"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
@@ -17,10 +18,10 @@ import (
 func WithCluster(cluster utils.ClusterConfigGeneratorFn) ManagerOption {
 	return &tableManagerOption{
 		parametersHook: func(ctx context.Context, params *tableManagerParameters) error {
+			params.queryTimeout = cluster().Timeout
 			params.SessionFactory = func(keyspace string) (*gocql.Session, error) {
 				clusterVal := cluster()
 				clusterVal.Keyspace = keyspace
-				params.queryTimeout = clusterVal.Timeout
 				return clusterVal.CreateSession()
 			}
 			return nil
@@ -28,6 +29,49 @@ func WithCluster(cluster utils.ClusterConfigGeneratorFn) ManagerOption {
 	}
 }
 
+// WithOperationTimeouts sets the time budget for each kind of operation. Every operation runs with a deadline
+// of the earlier of the caller's deadline and its budget, and reports running out of time as a *TimeoutError.
+// The write and LWT budgets cover any retries after write timeouts, and the scan page budget applies to each
+// page of a paged query. Budgets of zero or less fall back to the cluster timeout, if WithCluster is used,
+// and otherwise leave the caller's deadline as the only limit.
+func WithOperationTimeouts(read, write, lwt, scanPage time.Duration) ManagerOption {
+	return &tableManagerOption{
+		parametersHook: func(ctx context.Context, params *tableManagerParameters) error {
+			params.Timeouts = operationTimeouts{
+				read:     read,
+				write:    write,
+				lwt:      lwt,
+				scanPage: scanPage,
+			}
+			return nil
+		},
+	}
+}
+
+// WithSession sets a session for the manager to use, rather than creating its own. The session should be
+// bound to the manager's keyspace. It is left open when the manager is closed.
+func WithSession(session gocqlx.Session) ManagerOption {
+	return &tableManagerOption{
+		parametersHook: func(ctx context.Context, params *tableManagerParameters) error {
+			params.Session = &session
+			return nil
+		},
+	}
+}
+
+// WithSessionPool shares sessions between managers, with one session for each keyspace. The manager
+// acquires its session from the pool on startup and releases it when closed. Generator startup hooks
+// also use the pool, rather than opening sessions of their own.
+func WithSessionPool(pool *SessionPool) ManagerOption {
+	return &tableManagerOption{
+		parametersHook: func(ctx context.Context, params *tableManagerParameters) error {
+			params.SessionPool = pool
+			params.queryTimeout = pool.timeout
+			return nil
+		},
+	}
+}
+
 // WithDefaultReadConsistency sets the default read consistency
 func WithDefaultReadConsistency(level gocql.Consistency) ManagerOption {
 	return &tableManagerOption{
@@ -74,6 +118,112 @@ func WithDefaultTTL(d time.Duration) ManagerOption {
 	}
 }
 
+// WithAdaptiveLimiter sets the limiter used by bulk operations run with Adaptive concurrency. Sharing a
+// limiter between managers lets them back off together, and lets callers read its limit for metrics.
+func WithAdaptiveLimiter(limiter *AdaptiveLimiter) ManagerOption {
+	return &tableManagerOption{
+		parametersHook: func(ctx context.Context, params *tableManagerParameters) error {
+			params.Limiter = limiter
+			return nil
+		},
+	}
+}
+
+// WithScanBypassCache makes Scan add a BYPASS CACHE clause, so that full-table scans don't evict the
+// working set from Scylla's cache
+func WithScanBypassCache() ManagerOption {
+	return &tableManagerOption{
+		parametersHook: func(ctx context.Context, params *tableManagerParameters) error {
+			params.ScanBypassCache = true
+			return nil
+		},
+	}
+}
+
+// WithReadCoalescing shares the result of identical Get calls that are in progress at the same time, so
+// that bursts of reads for the same row send only one query. Reads are identical if they have the same
+// statement and bindings. Each caller gets its own copy of the record, and waits for at most its own
+// budget. The shared read only stops early if every caller waiting on it gives up.
+func WithReadCoalescing() ManagerOption {
+	return &tableManagerOption{
+		parametersHook: func(ctx context.Context, params *tableManagerParameters) error {
+			params.ReadCoalescing = true
+			return nil
+		},
+	}
+}
+
+// WithCache reads GetByPrimaryKey and GetByPartitionKey through a cache, keeping records for the TTL.
+// Writes through the same manager invalidate the rows they touch, and writes that can't identify their
+// rows, such as Truncate or deleting by options, invalidate everything. Writes from elsewhere are only
+// seen once entries expire. NewLRUCache provides a size-bounded cache.
+func WithCache(cache Cache, ttl time.Duration) ManagerOption {
+	return &tableManagerOption{
+		parametersHook: func(ctx context.Context, params *tableManagerParameters) error {
+			params.Cache = cache
+			params.CacheTTL = ttl
+			return nil
+		},
+	}
+}
+
+// WithNegativeCaching caches reads that find no record for the TTL, when used with WithCache
+func WithNegativeCaching(ttl time.Duration) ManagerOption {
+	return &tableManagerOption{
+		parametersHook: func(ctx context.Context, params *tableManagerParameters) error {
+			params.NegativeCacheTTL = ttl
+			return nil
+		},
+	}
+}
+
+// WithLazyStartup returns the manager without waiting for its session to be created or its startup hooks
+// to run. Startup is retried in the background, using the policy from WithStartupRetry if set, or retrying
+// indefinitely otherwise. Operations wait for startup to complete, bounded by their context, and return
+// ErrNotReady if it does not. Use Ready to check on startup, such as for a readiness probe.
+func WithLazyStartup() ManagerOption {
+	return &tableManagerOption{
+		parametersHook: func(ctx context.Context, params *tableManagerParameters) error {
+			params.LazyStartup = true
+			return nil
+		},
+	}
+}
+
+// WithStartupRetry retries session creation and startup hooks with backoff if they fail, rather than
+// failing on the first error. Without WithLazyStartup, retries happen before the manager is returned
+// and are bounded by the context it is created with.
+func WithStartupRetry(policy StartupRetryPolicy) ManagerOption {
+	return &tableManagerOption{
+		parametersHook: func(ctx context.Context, params *tableManagerParameters) error {
+			params.StartupRetry = &policy
+			return nil
+		},
+	}
+}
+
+// WithSchemaVerifier sets how Verify compares the live schema with the manager's specification. See
+// generator.WithSchemaVerification for the standard implementation.
+func WithSchemaVerifier(verifier SchemaVerifier) ManagerOption {
+	return &tableManagerOption{
+		parametersHook: func(ctx context.Context, params *tableManagerParameters) error {
+			params.SchemaVerifier = verifier
+			return nil
+		},
+	}
+}
+
+// WithFaultInjector passes every statement through a fault injector before it is sent, so that tests can
+// exercise handling of errors, latency and failed conditions. See the tables/chaos package.
+func WithFaultInjector(injector FaultInjector) ManagerOption {
+	return &tableManagerOption{
+		parametersHook: func(ctx context.Context, params *tableManagerParameters) error {
+			params.FaultInjector = injector
+			return nil
+		},
+	}
+}
+
 // WithKeyspace sets the keyspace of the table-manager
 func WithKeyspace(keyspace string) ManagerOption {
 	return &tableManagerOption{
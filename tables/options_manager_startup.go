@@ -18,6 +18,7 @@ type StartupOptions struct {
 	view  *metadata.ViewSpecification
 	types []*metadata.TypeSpecification
 	ddl   []metadata.DDLOperation
+	pool  *SessionPool
 }
 
 func (o *StartupOptions) Table() *metadata.TableSpecification {
@@ -36,6 +37,11 @@ func (o *StartupOptions) AdditionalDDL() []metadata.DDLOperation {
 	return o.ddl
 }
 
+// SessionPool gets the pool of sessions the manager uses, if any, so that startup functions can share it
+func (o *StartupOptions) SessionPool() *SessionPool {
+	return o.pool
+}
+
 // CollectStartupOptions creates a new initialised StartupOptions
 func CollectStartupOptions(options []StartupOption) *StartupOptions {
 	t := &StartupOptions{}
@@ -68,3 +74,9 @@ func WithAdditionalDDL(ddlOps ...metadata.DDLOperation) StartupOption {
 		options.ddl = append(options.ddl, ddlOps...)
 	}
 }
+
+func WithStartupSessionPool(pool *SessionPool) StartupOption {
+	return func(options *StartupOptions) {
+		options.pool = pool
+	}
+}
@@ -2,6 +2,7 @@ package tables
 
 import (
 	"slices"
+	"time"
 
 	"github.com/scylladb/gocqlx/v3"
 	"github.com/scylladb/gocqlx/v3/qb"
@@ -13,6 +14,9 @@ import (
 type queryOption struct {
 	queryMutator   func(q *gocqlx.Queryx) *gocqlx.Queryx
 	queryBuilderFn func(builder *qb.SelectBuilder) *qb.SelectBuilder
+	prefetch       int
+	timingFn       func(PageTiming)
+	budget         time.Duration
 	queryBindings  []any
 	cols           []string
 }
@@ -33,6 +37,18 @@ func (s *queryOption) applyToBuilder(builder *qb.SelectBuilder) *qb.SelectBuilde
 	return s.queryBuilderFn(builder)
 }
 
+func (s *queryOption) prefetchPages() int {
+	return s.prefetch
+}
+
+func (s *queryOption) pageTimingFn() func(PageTiming) {
+	return s.timingFn
+}
+
+func (s *queryOption) timeoutBudget() time.Duration {
+	return s.budget
+}
+
 func (s *queryOption) columns() []string {
 	return s.cols
 }
@@ -50,6 +66,40 @@ func WithPaging(pageSize int, state []byte) QueryOption {
 	}
 }
 
+// WithPrefetch fetches up to the given number of pages in the background while the page handler runs.
+// Pages are fetched one at a time, so this trades a bounded amount of memory for not waiting on the database
+// between pages. A value of zero or less fetches each page only once the handler has finished with the last.
+func WithPrefetch(pages int) QueryOption {
+	return &queryOption{
+		prefetch: max(pages, 0),
+	}
+}
+
+// WithPageTiming sets a function to receive the timing of each page of a paged query
+func WithPageTiming(fn func(timing PageTiming)) QueryOption {
+	return &queryOption{
+		timingFn: fn,
+	}
+}
+
+// WithBypassCache adds a BYPASS CACHE clause, so that Scylla reads the rows without populating its cache.
+// This suits one-off scans that would otherwise evict the working set.
+func WithBypassCache() QueryOption {
+	return &queryOption{
+		queryBuilderFn: func(builder *qb.SelectBuilder) *qb.SelectBuilder {
+			return builder.BypassCache()
+		},
+	}
+}
+
+// WithQueryTimeout overrides the manager's budget for this query. For paged queries the budget applies to
+// each page.
+func WithQueryTimeout(d time.Duration) QueryOption {
+	return &queryOption{
+		budget: d,
+	}
+}
+
 // WithSort sets the sort order for a query result
 func WithSort(column string, order int) QueryOption {
 	return &queryOption{
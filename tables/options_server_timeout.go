@@ -0,0 +1,88 @@
+package tables
+
+import (
+	"time"
+
+	"github.com/scylladb/gocqlx/v3"
+	"github.com/scylladb/gocqlx/v3/qb"
+)
+
+// ServerTimeoutOption is an option that can be given to queries, inserts, updates, upserts and deletes
+type ServerTimeoutOption interface {
+	QueryOption
+	UpsertOption
+	DeleteOption
+}
+
+// serverTimeoutOption adds a USING TIMEOUT clause to whichever statement it is applied to
+type serverTimeoutOption struct {
+	timeout time.Duration
+}
+
+func (s *serverTimeoutOption) applyToBuilder(builder *qb.SelectBuilder) *qb.SelectBuilder {
+	return builder.Timeout(s.timeout)
+}
+
+func (s *serverTimeoutOption) applyToInsertBuilder(builder *qb.InsertBuilder) *qb.InsertBuilder {
+	return builder.Timeout(s.timeout)
+}
+
+func (s *serverTimeoutOption) applyToUpdateBuilder(builder *qb.UpdateBuilder) *qb.UpdateBuilder {
+	return builder.Timeout(s.timeout)
+}
+
+func (s *serverTimeoutOption) applyToDeleteBuilder(builder *qb.DeleteBuilder) *qb.DeleteBuilder {
+	return builder.Timeout(s.timeout)
+}
+
+func (s *serverTimeoutOption) applyToQuery(q *gocqlx.Queryx) *gocqlx.Queryx {
+	return q
+}
+
+func (s *serverTimeoutOption) bindings() []any {
+	return nil
+}
+
+func (s *serverTimeoutOption) columns() []string {
+	return nil
+}
+
+func (s *serverTimeoutOption) conditions() []qb.Cmp {
+	return nil
+}
+
+func (s *serverTimeoutOption) expectedValues() map[string]any {
+	return nil
+}
+
+func (s *serverTimeoutOption) getMapData() map[string]any {
+	return nil
+}
+
+func (s *serverTimeoutOption) isPrecondition() bool {
+	return false
+}
+
+func (s *serverTimeoutOption) pageTimingFn() func(PageTiming) {
+	return nil
+}
+
+func (s *serverTimeoutOption) prefetchPages() int {
+	return 0
+}
+
+func (s *serverTimeoutOption) skipsNilColumns() bool {
+	return false
+}
+
+func (s *serverTimeoutOption) timeoutBudget() time.Duration {
+	return 0
+}
+
+// WithServerTimeout adds a USING TIMEOUT clause, so that Scylla abandons the statement on the server once the
+// duration passes. This option can be specified for queries, inserts, updates, upserts and deletes.
+func WithServerTimeout(d time.Duration) ServerTimeoutOption {
+	return &serverTimeoutOption{
+		timeout: d,
+	}
+}
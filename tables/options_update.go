@@ -10,15 +10,30 @@ import (
 // handling.
 type updateOption struct {
 	mapData           map[string]any
+	insertBuilderFn   func(builder *qb.InsertBuilder) *qb.InsertBuilder // Used when an update becomes an insert
 	updateBuilderFn   func(builder *qb.UpdateBuilder) *qb.UpdateBuilder
 	isOptPrecondition bool
+	skipNil           bool
+	budget            time.Duration
 }
 
 // Apply applies the update optionInsertBuilder
 func (u *updateOption) applyToUpdateBuilder(builder *qb.UpdateBuilder) *qb.UpdateBuilder {
+	if u.updateBuilderFn == nil {
+		return builder
+	}
 	return u.updateBuilderFn(builder)
 }
 
+// applyToInsertBuilder applies the option to writes that insert rather than update, such as
+// UpdateFunc creating a missing record
+func (u *updateOption) applyToInsertBuilder(builder *qb.InsertBuilder) *qb.InsertBuilder {
+	if u.insertBuilderFn == nil {
+		return builder
+	}
+	return u.insertBuilderFn(builder)
+}
+
 func (u *updateOption) getMapData() map[string]any {
 	return u.mapData
 }
@@ -27,6 +42,14 @@ func (u *updateOption) isPrecondition() bool {
 	return u.isOptPrecondition
 }
 
+func (u *updateOption) skipsNilColumns() bool {
+	return u.skipNil
+}
+
+func (u *updateOption) timeoutBudget() time.Duration {
+	return u.budget
+}
+
 // WithSimpleIf allows for a LWT that does a simple value-based comparison on a single column
 func WithSimpleIf(targetColumn string, val any) UpdateOption {
 	// Just needs to be a unique column name that won't be part of the table specification.
@@ -58,6 +81,9 @@ func WithConditionalUpdate(cmp qb.Cmp, payload map[string]any) UpdateOption {
 // WithUpdateTTL sets the TTL option for an update.
 func WithUpdateTTL(ttl time.Duration) UpdateOption {
 	return &updateOption{
+		insertBuilderFn: func(builder *qb.InsertBuilder) *qb.InsertBuilder {
+			return builder.TTL(ttl)
+		},
 		updateBuilderFn: func(builder *qb.UpdateBuilder) *qb.UpdateBuilder {
 			return builder.TTL(ttl)
 		},
@@ -76,6 +102,9 @@ func WithUpdateExists() UpdateOption {
 
 func WithUpdateUsingTimestamp(ts int64) UpdateOption {
 	return &updateOption{
+		insertBuilderFn: func(builder *qb.InsertBuilder) *qb.InsertBuilder {
+			return builder.Timestamp(time.UnixMilli(ts))
+		},
 		updateBuilderFn: func(builder *qb.UpdateBuilder) *qb.UpdateBuilder {
 			return builder.Timestamp(time.UnixMilli(ts))
 		},
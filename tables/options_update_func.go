@@ -0,0 +1,77 @@
+package tables
+
+import (
+	"time"
+
+	"github.com/gocql/gocql"
+)
+
+// UpdateFuncOption is an option that changes how an UpdateFunc read-modify-write cycle is performed
+type UpdateFuncOption func(params *updateFuncParameters)
+
+// updateFuncParameters are the parameters of a read-modify-write cycle
+type updateFuncParameters struct {
+	maxAttempts       int               // Number of attempts before giving up
+	initialBackoff    time.Duration     // Delay before the first retry
+	maxBackoff        time.Duration     // Cap on the delay between retries
+	serialConsistency gocql.Consistency // Consistency for the read
+	versionColumn     string            // Column to use as the LWT condition, if any
+	writeOpts         []UpdateOption    // Additional options for the write
+}
+
+// collectUpdateFuncParameters builds the parameters for a read-modify-write cycle
+func collectUpdateFuncParameters(opts []UpdateFuncOption) *updateFuncParameters {
+	params := &updateFuncParameters{
+		maxAttempts:       DefaultUpdateFuncAttempts,
+		initialBackoff:    DefaultUpdateFuncInitialBackoff,
+		maxBackoff:        DefaultUpdateFuncMaxBackoff,
+		serialConsistency: gocql.Serial,
+	}
+	for _, opt := range opts {
+		opt(params)
+	}
+	if params.maxAttempts < 1 {
+		params.maxAttempts = 1
+	}
+	return params
+}
+
+// WithUpdateFuncAttempts sets the number of attempts made before giving up with ErrPreconditionFailed
+func WithUpdateFuncAttempts(attempts int) UpdateFuncOption {
+	return func(params *updateFuncParameters) {
+		params.maxAttempts = attempts
+	}
+}
+
+// WithUpdateFuncBackoff sets the delay before the first retry, which doubles on each subsequent
+// retry up to the given maximum.
+func WithUpdateFuncBackoff(initial time.Duration, maximum time.Duration) UpdateFuncOption {
+	return func(params *updateFuncParameters) {
+		params.initialBackoff = initial
+		params.maxBackoff = maximum
+	}
+}
+
+// WithUpdateFuncSerialConsistency sets the serial consistency used to read the current record,
+// typically gocql.Serial or gocql.LocalSerial.
+func WithUpdateFuncSerialConsistency(level gocql.Consistency) UpdateFuncOption {
+	return func(params *updateFuncParameters) {
+		params.serialConsistency = level
+	}
+}
+
+// WithUpdateFuncVersionColumn conditions the write on a single version column being unchanged,
+// rather than on every changed column. The update function is responsible for advancing the version.
+func WithUpdateFuncVersionColumn(column string) UpdateFuncOption {
+	return func(params *updateFuncParameters) {
+		params.versionColumn = column
+	}
+}
+
+// WithUpdateFuncWriteOptions adds options to the write, such as a TTL. They also apply when a missing
+// record is inserted, except for preconditions, which can't hold for a missing record.
+func WithUpdateFuncWriteOptions(opts ...UpdateOption) UpdateFuncOption {
+	return func(params *updateFuncParameters) {
+		params.writeOpts = append(params.writeOpts, opts...)
+	}
+}
@@ -11,6 +11,8 @@ type upsertOption struct {
 	insertBuilderFn   func(builder *qb.InsertBuilder) *qb.InsertBuilder
 	updateBuilderFn   func(builder *qb.UpdateBuilder) *qb.UpdateBuilder
 	isOptPrecondition bool
+	skipNil           bool
+	budget            time.Duration
 }
 
 // Apply applies the update optionInsertBuilder
@@ -37,6 +39,14 @@ func (u *upsertOption) getMapData() map[string]any {
 	return u.mapData
 }
 
+func (u *upsertOption) skipsNilColumns() bool {
+	return u.skipNil
+}
+
+func (u *upsertOption) timeoutBudget() time.Duration {
+	return u.budget
+}
+
 // WithTTL sets the TTL option for an upsert.
 func WithTTL(d time.Duration) UpsertOption {
 	return &upsertOption{
@@ -50,6 +60,14 @@ func WithTTL(d time.Duration) UpsertOption {
 	}
 }
 
+// WithOperationTimeout overrides the manager's budget for this write, including any retries. This option can
+// be specified for inserts, updates or upserts.
+func WithOperationTimeout(d time.Duration) UpsertOption {
+	return &upsertOption{
+		budget: d,
+	}
+}
+
 // WithSimpleUpsertIf allows for a LWT that does a simple value-based comparison on a single column
 func WithSimpleUpsertIf(targetColumn string, val any) UpsertOption {
 	// Just needs to be a unique column name that won't be part of the
@@ -90,8 +108,20 @@ func WithUpsertExists() UpsertOption {
 
 func WithUpsertUsingTimestamp(ts int64) UpsertOption {
 	return &upsertOption{
+		insertBuilderFn: func(builder *qb.InsertBuilder) *qb.InsertBuilder {
+			return builder.Timestamp(time.UnixMilli(ts))
+		},
 		updateBuilderFn: func(builder *qb.UpdateBuilder) *qb.UpdateBuilder {
 			return builder.Timestamp(time.UnixMilli(ts))
 		},
 	}
 }
+
+// WithSkipNilColumns leaves any non-key column that is a nil pointer, or a nil or empty slice or
+// map, unset when writing. Unset columns are left untouched rather than overwritten with a null,
+// which avoids writing tombstones. This can be combined with TTL and LWT options.
+func WithSkipNilColumns() UpsertOption {
+	return &upsertOption{
+		skipNil: true,
+	}
+}
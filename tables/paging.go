@@ -2,8 +2,10 @@ package tables
 
 import (
 	"context"
+	"time"
 
 	"github.com/scylladb/gocqlx/v3"
+	"go.uber.org/zap"
 )
 
 // PageHandlerFn is a function used when querying a block of records from the table. If true is returned
@@ -14,35 +16,61 @@ type PageHandlerFn[T any] func(ctx context.Context, records []*T, originalPaging
 // QueryBuilderFn is a function used to provide custom query instances to execute.
 type QueryBuilderFn func(ctx context.Context, sess gocqlx.Session) *gocqlx.Queryx
 
+// PageTiming describes the time spent on a single page of a paged query
+type PageTiming struct {
+	Page    int           // Index of the page, starting at zero
+	Records int           // Number of records in the page
+	Fetch   time.Duration // Time spent fetching the page from the database
+	Wait    time.Duration // Time the handler spent waiting for the page to be available
+	Handle  time.Duration // Time spent in the page handler
+}
+
+// pagingParameters are the settings that control how a paged query is driven
+type pagingParameters struct {
+	prefetch int              // Number of pages to fetch ahead of the handler
+	timingFn func(PageTiming) // Receives the timing of each page, if set
+}
+
+// fetchedPage is a page of records, as passed from a prefetching goroutine
+type fetchedPage[T any] struct {
+	records       []*T
+	pageState     []byte
+	nextPageState []byte
+	fetchTime     time.Duration
+	err           error
+}
+
 // pageQueryInternal performs paging of a query
 func (t *baseManagerImpl[T]) pageQueryInternal(ctx context.Context, queryBuilder QueryBuilderFn, fn PageHandlerFn[T], opts ...QueryOption) error {
-	var pageState []byte
-
-	for {
-		query := queryBuilder(ctx, t.Session).
-			Consistency(t.readConsistency).
-			PageSize(DefaultPageSize)
+	return pageQuery(ctx, t, queryBuilder, t.lifecycle.afterLoadPage(fn), opts...)
+}
 
-		// Apply query options that can override any of the above
-		for _, opt := range opts {
-			if opt == nil {
-				continue
-			}
-			query = opt.applyToQuery(query)
+// pageQuery performs paging of a query against a manager, decoding rows as R. R is usually the record
+// type of the manager, but may be another type selecting a subset of its columns.
+func pageQuery[T, R any](ctx context.Context, t *baseManagerImpl[T], queryBuilder QueryBuilderFn, fn PageHandlerFn[R], opts ...QueryOption) error {
+	params := pagingParameters{}
+	for _, opt := range opts {
+		if opt == nil {
+			continue
 		}
-		if pageState != nil {
-			query = query.PageState(pageState)
+		if n := opt.prefetchPages(); n > 0 {
+			params.prefetch = n
 		}
-
-		// Check for any binding errors
-		if query.Err() != nil {
-			return query.Err()
+		if fn := opt.pageTimingFn(); fn != nil {
+			params.timingFn = fn
 		}
+	}
+
+	if params.prefetch > 0 {
+		return pagePrefetch(ctx, t, queryBuilder, fn, params, opts...)
+	}
 
-		iter := query.Iter()
+	var pageState []byte
 
-		records, updatedPageState, err := t.fetchOnePage(ctx, iter)
-		query.Release()
+	for page := 0; ; page++ {
+		st := time.Now()
+		records, updatedPageState, err := fetchPage[T, R](ctx, t, queryBuilder, pageState, opts...)
+		fetchTime := time.Since(st)
 
 		if err != nil {
 			return err
@@ -50,7 +78,14 @@ func (t *baseManagerImpl[T]) pageQueryInternal(ctx context.Context, queryBuilder
 			break
 		}
 
+		st = time.Now()
 		keepGoing, errHandle := fn(ctx, records, pageState, updatedPageState)
+		t.reportPageTiming(params, PageTiming{
+			Page:    page,
+			Records: len(records),
+			Fetch:   fetchTime,
+			Handle:  time.Since(st),
+		})
 		if errHandle != nil {
 			return errHandle
 		}
@@ -67,12 +102,156 @@ func (t *baseManagerImpl[T]) pageQueryInternal(ctx context.Context, queryBuilder
 	return nil
 }
 
+// pagePrefetch performs paging of a query, fetching pages in a background goroutine while the
+// handler runs. At most params.prefetch pages are held ahead of the handler.
+func pagePrefetch[T, R any](ctx context.Context, t *baseManagerImpl[T], queryBuilder QueryBuilderFn, fn PageHandlerFn[R], params pagingParameters, opts ...QueryOption) error {
+	fetchCtx, cancel := context.WithCancel(ctx)
+	pages := make(chan fetchedPage[R], params.prefetch-1)
+	done := make(chan struct{})
+
+	// Stop the fetcher and wait for it to exit before we return, so no queries outlive the call
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	go func() {
+		defer close(done)
+		defer close(pages)
+
+		var pageState []byte
+		for {
+			st := time.Now()
+			records, updatedPageState, err := fetchPage[T, R](fetchCtx, t, queryBuilder, pageState, opts...)
+			fetched := fetchedPage[R]{
+				records:       records,
+				pageState:     pageState,
+				nextPageState: updatedPageState,
+				fetchTime:     time.Since(st),
+				err:           err,
+			}
+
+			select {
+			case pages <- fetched:
+			case <-fetchCtx.Done():
+				return
+			}
+
+			if err != nil || len(records) == 0 || len(updatedPageState) == 0 {
+				return
+			}
+			pageState = updatedPageState
+		}
+	}()
+
+	for page := 0; ; page++ {
+		st := time.Now()
+		var fetched fetchedPage[R]
+		var ok bool
+		select {
+		case fetched, ok = <-pages:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		waitTime := time.Since(st)
+
+		if !ok {
+			break
+		} else if fetched.err != nil {
+			return fetched.err
+		} else if len(fetched.records) == 0 {
+			break
+		}
+
+		st = time.Now()
+		keepGoing, errHandle := fn(ctx, fetched.records, fetched.pageState, fetched.nextPageState)
+		t.reportPageTiming(params, PageTiming{
+			Page:    page,
+			Records: len(fetched.records),
+			Fetch:   fetched.fetchTime,
+			Wait:    waitTime,
+			Handle:  time.Since(st),
+		})
+		if errHandle != nil {
+			return errHandle
+		}
+
+		// If we're stopping, or there's no additional paging state
+		if !keepGoing || len(fetched.nextPageState) == 0 {
+			break
+		}
+	}
+
+	return nil
+}
+
+// fetchPage builds the query for a page and fetches it, within the scan page budget
+func fetchPage[T, R any](ctx context.Context, t *baseManagerImpl[T], queryBuilder QueryBuilderFn, pageState []byte, opts ...QueryOption) ([]*R, []byte, error) {
+	page, err := returnWithBudget(ctx, t.startup, t.Name+"/FetchPage", budgetFor(t.timeouts.scanPage, opts), func(ctx context.Context) (fetchedPage[R], error) {
+		records, updatedPageState, err := fetchPageInternal[T, R](ctx, t, queryBuilder, pageState, opts...)
+		return fetchedPage[R]{records: records, nextPageState: updatedPageState}, err
+	})
+	return page.records, page.nextPageState, err
+}
+
+// fetchPageInternal builds the query for a page and fetches it
+func fetchPageInternal[T, R any](ctx context.Context, t *baseManagerImpl[T], queryBuilder QueryBuilderFn, pageState []byte, opts ...QueryOption) ([]*R, []byte, error) {
+	query := queryBuilder(ctx, t.Session).
+		Consistency(t.readConsistency).
+		PageSize(DefaultPageSize)
+
+	// Apply query options that can override any of the above
+	for _, opt := range opts {
+		if opt == nil {
+			continue
+		}
+		query = opt.applyToQuery(query)
+	}
+	if pageState != nil {
+		query = query.PageState(pageState)
+	}
+
+	// Check for any binding errors
+	if query.Err() != nil {
+		return nil, nil, query.Err()
+	}
+
+	if err := t.faultyRead(ctx); err != nil {
+		query.Release()
+		return nil, nil, err
+	}
+
+	iter := query.Iter()
+
+	records, updatedPageState, err := fetchOnePage[R](ctx, iter)
+	query.Release()
+
+	return records, updatedPageState, err
+}
+
 // fetchOnePage fetches a single page of a paged query
-func (t *baseManagerImpl[T]) fetchOnePage(ctx context.Context, iter *gocqlx.Iterx) ([]*T, []byte, error) {
+func fetchOnePage[R any](ctx context.Context, iter *gocqlx.Iterx) ([]*R, []byte, error) {
 	if ctx.Err() != nil {
 		return nil, nil, ctx.Err()
 	}
 
-	var result []*T
+	var result []*R
 	return result, iter.PageState(), iter.Select(&result)
 }
+
+// reportPageTiming logs the timing of a page, and passes it to any timing function
+func (t *baseManagerImpl[T]) reportPageTiming(params pagingParameters, timing PageTiming) {
+	if t.Logger != nil {
+		t.Logger.Debug("page handled",
+			zap.Int("page", timing.Page),
+			zap.Int("records", timing.Records),
+			zap.Duration("fetch", timing.Fetch),
+			zap.Duration("wait", timing.Wait),
+			zap.Duration("handle", timing.Handle),
+		)
+	}
+
+	if params.timingFn != nil {
+		params.timingFn(timing)
+	}
+}
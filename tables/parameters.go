@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"github.com/gocql/gocql"
+	"github.com/scylladb/go-reflectx"
+	"github.com/scylladb/gocqlx/v3"
 	"go.opentelemetry.io/otel/trace"
 	"go.opentelemetry.io/otel/trace/noop"
 	"go.uber.org/zap"
@@ -16,6 +18,8 @@ type tableManagerParameters struct {
 	Keyspace         string
 	Logger           *zap.Logger
 	SessionFactory   SessionFactory
+	Session          *gocqlx.Session
+	SessionPool      *SessionPool
 	TracerProvider   trace.TracerProvider
 	DoTracing        bool
 	TableSpec        *metadata.TableSpecification
@@ -24,11 +28,53 @@ type tableManagerParameters struct {
 	ReadConsistency  gocql.Consistency
 	WriteConsistency gocql.Consistency
 	TTL              time.Duration
+	Limiter          *AdaptiveLimiter
+	ScanBypassCache  bool
+	Timeouts         operationTimeouts
+	LazyStartup      bool
+	StartupRetry     *StartupRetryPolicy
+	SchemaVerifier   SchemaVerifier
+	FaultInjector    FaultInjector
+	ReadCoalescing   bool
+	Cache            Cache
+	CacheTTL         time.Duration
+	NegativeCacheTTL time.Duration
 	queryTimeout     time.Duration // Populated when the cluster options are set.
 }
 
 type SessionFactory func(keyspace string) (*gocql.Session, error)
 
+// mapper gets the mapper of the session the manager will use
+func (t *tableManagerParameters) mapper() *reflectx.Mapper {
+	if t.Session != nil && t.Session.Mapper != nil {
+		return t.Session.Mapper
+	}
+	return gocqlx.DefaultMapper
+}
+
+// openSession gets the session for the manager, along with a function that releases it once the
+// manager is closed. Sessions passed in with WithSession are left for the caller to close.
+func (t *tableManagerParameters) openSession() (gocqlx.Session, func(), error) {
+	switch {
+	case t.Session != nil:
+		return *t.Session, func() {}, nil
+	case t.SessionPool != nil:
+		session, err := t.SessionPool.Acquire(t.Keyspace)
+		if err != nil {
+			return gocqlx.Session{}, nil, err
+		}
+		return session, func() { t.SessionPool.Release(t.Keyspace) }, nil
+	case t.SessionFactory != nil:
+		session, err := gocqlx.WrapSession(t.SessionFactory(t.Keyspace))
+		if err != nil {
+			return gocqlx.Session{}, nil, err
+		}
+		return session, session.Close, nil
+	default:
+		return gocqlx.Session{}, nil, ErrNoSession
+	}
+}
+
 func (t *tableManagerParameters) ensureDefaults() {
 	t.Logger = zap.NewNop()
 	t.TracerProvider = noop.NewTracerProvider()
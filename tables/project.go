@@ -0,0 +1,108 @@
+package tables
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/scylladb/go-reflectx"
+	"github.com/scylladb/gocqlx/v3"
+)
+
+// projectionMapper maps the fields of projections to columns by their cql tags, falling back to the
+// snake case of the field name
+var projectionMapper = reflectx.NewMapperFunc(columnTagName, reflectx.CamelToSnakeASCII)
+
+// Projectable is the part of TableManager and ViewManager needed by SelectAs and GetAs, so that rows can
+// be read from either kind of manager into another type
+type Projectable[T any] interface {
+	GetUsingOptions(ctx context.Context, opts ...QueryOption) (*T, error)
+}
+
+// projectionSource is implemented by our managers, giving SelectAs and GetAs access to their session
+// and settings
+type projectionSource[T any] interface {
+	base() *baseManagerImpl[T]
+}
+
+// base gets the base manager implementation
+func (t *baseManagerImpl[T]) base() *baseManagerImpl[T] {
+	return t
+}
+
+// SelectAs selects rows from a table or view using query options, decoding each row as U rather than
+// the manager's record type. Only the columns of U are selected, so there is no need for WithColumns.
+// Columns are named by U's cql tags, or the snake case of untagged field names, and must all be columns
+// of the table or view. The manager's session, read consistency, budgets and tracing are used.
+func SelectAs[T, U any](ctx context.Context, manager Projectable[T], fn PageHandlerFn[U], opts ...QueryOption) error {
+	t, columns, err := projection[T, U](manager)
+	if err != nil {
+		return err
+	}
+
+	return doWithTracing(ctx, t.Tracer, t.Name+"/SelectAs", t.TraceAttributes, t.DoTracing, func(ctx context.Context) error {
+		return pageQuery(ctx, t, func(ctx context.Context, sess gocqlx.Session) *gocqlx.Queryx {
+			stmt, params := t.basicQueryBuilder(opts...).Columns(columns...).ToCql()
+			query := t.Session.Query(stmt, params).WithContext(ctx).Bind(t.bindings(opts...)...)
+			query.Mapper = projectionMapper
+			return query
+		}, fn, opts...)
+	})
+}
+
+// GetAs gets the first row found using query options, decoding it as U rather than the manager's record
+// type. Columns are chosen from U as for SelectAs.
+func GetAs[T, U any](ctx context.Context, manager Projectable[T], opts ...QueryOption) (*U, error) {
+	t, columns, err := projection[T, U](manager)
+	if err != nil {
+		return nil, err
+	}
+
+	return returnWithTracing(ctx, t.Tracer, t.Name+"/GetAs", t.TraceAttributes, t.DoTracing, func(ctx context.Context) (*U, error) {
+		return returnWithBudget(ctx, t.startup, t.Name+"/GetAs", budgetFor(t.timeouts.read, opts), func(ctx context.Context) (*U, error) {
+			stmt, params := t.basicQueryBuilder(opts...).Columns(columns...).ToCql()
+			query := t.Session.Query(stmt, params).WithContext(ctx).Consistency(t.readConsistency).Bind(t.bindings(opts...)...)
+			query.Mapper = projectionMapper
+
+			var target U
+			errQuery := t.faultyGet(ctx, query, &target)
+			return &target, errQuery
+		})
+	})
+}
+
+// projection gets the manager implementation behind a Projectable, and the columns to select for U
+func projection[T, U any](manager Projectable[T]) (*baseManagerImpl[T], []string, error) {
+	source, ok := manager.(projectionSource[T])
+	if !ok {
+		return nil, nil, fmt.Errorf("%w: %T", ErrUnsupportedManager, manager)
+	}
+	t := source.base()
+
+	columns := projectedColumns[U](projectionMapper)
+	if len(columns) == 0 {
+		return nil, nil, fmt.Errorf("%w: %v has no columns", ErrUnknownColumn, reflect.TypeFor[U]())
+	}
+	for _, column := range columns {
+		if !slices.Contains(t.allColumnNames, column) {
+			return nil, nil, fmt.Errorf("%w: %q of %v is not a column of %s", ErrUnknownColumn, column, reflect.TypeFor[U](), t.Name)
+		}
+	}
+
+	return t, columns, nil
+}
+
+// projectedColumns gets the columns a type maps to. Fields of embedded structs are included after the
+// type's own fields, while the fields of other nested structs belong to their parent's column.
+func projectedColumns[U any](mapper *reflectx.Mapper) []string {
+	var columns []string
+	for _, field := range mapper.TypeMap(reflect.TypeFor[U]()).Index {
+		if field.Embedded || strings.Contains(field.Path, ".") || slices.Contains(columns, field.Path) {
+			continue
+		}
+		columns = append(columns, field.Path)
+	}
+	return columns
+}
@@ -0,0 +1,31 @@
+package tables
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// projectedKeys is embedded in projection test types
+type projectedKeys struct {
+	OrderID string `cql:"order_id"`
+}
+
+// projectedItem is a projection of a record, with embedded and nested fields
+type projectedItem struct {
+	projectedKeys
+	Quantity int `cql:"qty"`
+	Detail   struct {
+		Note string
+	} `cql:"detail"`
+	Ignored string `cql:"-"`
+}
+
+// TestProjectedColumns checks the columns of a projection are derived from its fields
+func TestProjectedColumns(t *testing.T) {
+	// Act
+	columns := projectedColumns[projectedItem](projectionMapper)
+
+	// Assert
+	require.Equal(t, []string{"qty", "detail", "order_id"}, columns, "Should map own, nested and embedded fields")
+}
@@ -0,0 +1,82 @@
+package tables
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/scylladb/gocqlx/v3"
+
+	"github.com/zeroflucs-given/charybdis/metadata"
+)
+
+// Query runs a named query declared on the table or view specification, binding its parameters by name.
+// Every declared parameter must be given, and no others.
+func (t *baseManagerImpl[T]) Query(ctx context.Context, name string, fn PageHandlerFn[T], params map[string]any, opts ...QueryOption) error {
+	return doWithTracing(ctx, t.Tracer, t.Name+"/Query/"+name, t.TraceAttributes, t.DoTracing, func(ctx context.Context) error {
+		query, ok := t.queries[name]
+		if !ok {
+			return fmt.Errorf("%w: %q", ErrUnknownQuery, name)
+		}
+		if err := checkQueryParameters(query, params); err != nil {
+			return fmt.Errorf("query %q: %w", name, err)
+		}
+
+		return t.pageQueryInternal(ctx, func(ctx context.Context, sess gocqlx.Session) *gocqlx.Queryx {
+			return sess.ContextQuery(ctx, query.Statement, query.Parameters).
+				Consistency(t.readConsistency).
+				BindMap(params)
+		}, fn, opts...)
+	})
+}
+
+// checkQueryParameters checks the parameters given for a query match those it declares
+func checkQueryParameters(query *metadata.ParameterizedQuery, params map[string]any) error {
+	var missing, extra []string
+	for _, name := range query.Parameters {
+		if _, ok := params[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	for name := range params {
+		if !slices.Contains(query.Parameters, name) {
+			extra = append(extra, name)
+		}
+	}
+	sort.Strings(extra)
+
+	switch {
+	case len(missing) > 0 && len(extra) > 0:
+		return fmt.Errorf("%w: missing %v, unexpected %v", ErrQueryParameters, missing, extra)
+	case len(missing) > 0:
+		return fmt.Errorf("%w: missing %v", ErrQueryParameters, missing)
+	case len(extra) > 0:
+		return fmt.Errorf("%w: unexpected %v", ErrQueryParameters, extra)
+	}
+	return nil
+}
+
+// prepareQueries prepares each named query on the session, so that statements the database rejects are
+// found during startup rather than when first run
+func prepareQueries(ctx context.Context, sess gocqlx.Session, queries map[string]*metadata.ParameterizedQuery) error {
+	names := make([]string, 0, len(queries))
+	for name := range queries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		query := queries[name]
+
+		// The driver has no explicit prepare, but must prepare the statement to work out its routing key
+		_, err := sess.Session.Query(query.Statement, make([]any, len(query.Parameters))...).
+			WithContext(ctx).
+			GetRoutingKey()
+		if err != nil {
+			return fmt.Errorf("preparing query %q: %w", name, err)
+		}
+	}
+
+	return nil
+}
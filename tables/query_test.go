@@ -0,0 +1,123 @@
+package tables_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/zeroflucs-given/charybdis/generator"
+	"github.com/zeroflucs-given/charybdis/metadata"
+	"github.com/zeroflucs-given/charybdis/tables"
+)
+
+// TestNamedQuery checks a query declared on the spec can be run with named parameters
+func TestNamedQuery(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+	logger := zaptest.NewLogger(t)
+
+	spec := OrderItemsTableSpec.Clone(false)
+	spec.Queries = map[string]*metadata.ParameterizedQuery{
+		"large_items": {
+			Statement:  "SELECT * FROM order_items WHERE order_id = ? AND item_id >= ? AND quantity >= ? ALLOW FILTERING",
+			Parameters: []string{"order_id", "from_item", "min_quantity"},
+		},
+	}
+
+	manager, err := tables.NewTableManager[OrderItem](ctx,
+		tables.WithCluster(testClusterConfig),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(spec),
+		generator.WithAutomaticTableManagement(logger, testClusterConfig))
+	require.NoError(t, err, "Should not error starting up")
+	defer manager.Close()
+
+	// Arrange
+	for i := range 20 {
+		errInsert := manager.Insert(ctx, &OrderItem{
+			OrderID:  "named-query-1",
+			ItemID:   fmt.Sprintf("item-%02d", i),
+			Quantity: i,
+		})
+		require.NoError(t, errInsert, "Should not error inserting")
+	}
+
+	// Act
+	var found []*OrderItem
+	errQuery := manager.Query(ctx, "large_items", func(ctx context.Context, records []*OrderItem, pageState []byte, newPageState []byte) (bool, error) {
+		found = append(found, records...)
+		return true, nil
+	}, map[string]any{
+		"order_id":     "named-query-1",
+		"from_item":    "item-05",
+		"min_quantity": 10,
+	}, tables.WithPaging(3, nil))
+
+	// Assert
+	require.NoError(t, errQuery, "Should not error querying")
+	require.Len(t, found, 10, "Should find items matching the query")
+	require.Equal(t, "item-10", found[0].ItemID, "Should decode records in clustering order")
+}
+
+// TestNamedQueryParameters checks a query with missing or unexpected parameters is rejected
+func TestNamedQueryParameters(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+
+	spec := OrderItemsTableSpec.Clone(false)
+	spec.Queries = map[string]*metadata.ParameterizedQuery{
+		"by_order": {
+			Statement:  "SELECT * FROM order_items WHERE order_id = ?",
+			Parameters: []string{"order_id"},
+		},
+	}
+
+	manager, err := tables.NewTableManager[OrderItem](ctx,
+		tables.WithCluster(testClusterConfig),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(spec))
+	require.NoError(t, err, "Should not error starting up")
+	defer manager.Close()
+
+	noop := func(ctx context.Context, records []*OrderItem, pageState []byte, newPageState []byte) (bool, error) {
+		return true, nil
+	}
+
+	// Act
+	errMissing := manager.Query(ctx, "by_order", noop, map[string]any{})
+	errExtra := manager.Query(ctx, "by_order", noop, map[string]any{"order_id": "a", "item_id": "b"})
+	errUnknown := manager.Query(ctx, "by_customer", noop, nil)
+
+	// Assert
+	require.ErrorIs(t, errMissing, tables.ErrQueryParameters, "Should reject missing parameters")
+	require.ErrorContains(t, errMissing, "missing [order_id]", "Should name the missing parameter")
+	require.ErrorIs(t, errExtra, tables.ErrQueryParameters, "Should reject unexpected parameters")
+	require.ErrorContains(t, errExtra, "unexpected [item_id]", "Should name the unexpected parameter")
+	require.ErrorIs(t, errUnknown, tables.ErrUnknownQuery, "Should reject unknown queries")
+}
+
+// TestNamedQueryValidation checks invalid queries are rejected when the manager is created
+func TestNamedQueryValidation(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+
+	spec := OrderItemsTableSpec.Clone(false)
+	spec.Queries = map[string]*metadata.ParameterizedQuery{
+		"by_order": {
+			Statement:  "SELECT * FROM order_items WHERE order_id = ? AND item_id = ?",
+			Parameters: []string{"order_id"},
+		},
+	}
+
+	// Act
+	_, err := tables.NewTableManager[OrderItem](ctx,
+		tables.WithCluster(testClusterConfig),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(spec))
+
+	// Assert
+	require.ErrorIs(t, err, metadata.ErrInvalidQuery, "Should reject a query with too few parameters")
+}
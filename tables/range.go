@@ -0,0 +1,137 @@
+package tables
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/scylladb/gocqlx/v3"
+	"github.com/scylladb/gocqlx/v3/qb"
+)
+
+// Range describes a slice of a partition by its clustering columns. From and To hold values for a
+// prefix of the clustering columns, in clustering order. Bounds are expressed in the order rows are
+// stored, so for a descending clustering column From is the larger value. An empty bound is open.
+type Range struct {
+	From          []any // Values the range starts from
+	To            []any // Values the range ends at
+	FromInclusive bool  // Include rows equal to From
+	ToInclusive   bool  // Include rows equal to To
+}
+
+// SelectRange gets all records within a partition that fall within a range of clustering keys
+func (t *baseManagerImpl[T]) SelectRange(ctx context.Context, fn PageHandlerFn[T], partitionKeys []any, r Range, opts ...QueryOption) error {
+	return doWithTracing(ctx, t.Tracer, t.Name+"/SelectRange", t.TraceAttributes, t.DoTracing, func(ctx context.Context) error {
+		predicates, rangeBindings, err := t.rangePredicates(partitionKeys, r)
+		if err != nil {
+			return err
+		}
+
+		return t.pageQueryInternal(ctx, func(ctx context.Context, sess gocqlx.Session) *gocqlx.Queryx {
+			stmt, params := t.basicQueryBuilder(opts...).Where(predicates...).ToCql()
+			bindings := append(t.bindings(opts...), rangeBindings...)
+			return t.Session.Query(stmt, params).WithContext(ctx).Bind(bindings...)
+		}, fn, opts...)
+	})
+}
+
+// DeleteRange removes all records within a partition that fall within a range of clustering keys. If
+// there are pre-delete hooks, the range is first read page by page and each row passed to the hooks.
+func (t *tableManagerImpl[T]) DeleteRange(ctx context.Context, partitionKeys []any, r Range) error {
+	defer t.cache.invalidateAll()
+
+	return doWithTracing(ctx, t.Tracer, t.Name+"/DeleteRange", t.TraceAttributes, t.DoTracing, func(ctx context.Context) error {
+		predicates, bindings, err := t.rangePredicates(partitionKeys, r)
+		if err != nil {
+			return err
+		}
+
+		// Pre-delete hooks
+		if len(t.preDeleteHooks) > 0 {
+			errSelect := t.SelectRange(ctx, t.preDeletePage, partitionKeys, r)
+			if errSelect != nil {
+				return errSelect
+			}
+		}
+
+		_, err = t.execDelete(ctx, false, bindings, WithDeletePredicates(predicates...))
+		return err
+	})
+}
+
+// rangePredicates builds the predicates and bindings selecting a range within a partition
+func (t *baseManagerImpl[T]) rangePredicates(partitionKeys []any, r Range) ([]qb.Cmp, []any, error) {
+	if len(partitionKeys) != len(t.partitionKeyPredicates) {
+		return nil, nil, fmt.Errorf("%w: expected %d partition key values, got %d", ErrKeyCount, len(t.partitionKeyPredicates), len(partitionKeys))
+	}
+
+	predicates := append([]qb.Cmp{}, t.partitionKeyPredicates...)
+	bindings := append([]any{}, partitionKeys...)
+
+	for _, bound := range []struct {
+		values    []any
+		inclusive bool
+		isStart   bool
+	}{
+		{values: r.From, inclusive: r.FromInclusive, isStart: true},
+		{values: r.To, inclusive: r.ToInclusive, isStart: false},
+	} {
+		if len(bound.values) == 0 {
+			continue
+		}
+
+		cmp, err := t.rangeBound(len(bound.values), bound.inclusive, bound.isStart)
+		if err != nil {
+			return nil, nil, err
+		}
+		predicates = append(predicates, cmp)
+		bindings = append(bindings, bound.values...)
+	}
+
+	return predicates, bindings, nil
+}
+
+// rangeBound builds a comparison over the first n clustering columns. Tuple comparisons use the natural
+// ordering of the values, so all columns in the tuple must share a direction for the range to be contiguous.
+func (t *baseManagerImpl[T]) rangeBound(n int, inclusive bool, isStart bool) (qb.Cmp, error) {
+	if n > len(t.clustering) {
+		return qb.Cmp{}, fmt.Errorf("%w: range has %d values but the table has %d clustering columns", ErrInvalidRange, n, len(t.clustering))
+	}
+
+	descending := t.clustering[0].Descending
+	columns := make([]string, n)
+	for i, c := range t.clustering[:n] {
+		if c.Descending != descending {
+			return qb.Cmp{}, fmt.Errorf("%w: clustering columns %q and %q have different orders", ErrInvalidRange, t.clustering[0].Column.Name, c.Column.Name)
+		}
+		columns[i] = c.Column.Name
+	}
+
+	// Starting a descending range means working down from the larger value
+	lower := isStart != descending
+
+	if n == 1 {
+		switch {
+		case lower && inclusive:
+			return qb.GtOrEq(columns[0]), nil
+		case lower:
+			return qb.Gt(columns[0]), nil
+		case inclusive:
+			return qb.LtOrEq(columns[0]), nil
+		default:
+			return qb.Lt(columns[0]), nil
+		}
+	}
+
+	tuple := "(" + strings.Join(columns, ",") + ")"
+	switch {
+	case lower && inclusive:
+		return qb.GtOrEqTuple(tuple, n), nil
+	case lower:
+		return qb.GtTuple(tuple, n), nil
+	case inclusive:
+		return qb.LtOrEqTuple(tuple, n), nil
+	default:
+		return qb.LtTuple(tuple, n), nil
+	}
+}
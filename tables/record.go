@@ -0,0 +1,185 @@
+package tables
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/gocql/gocql"
+	"github.com/scylladb/go-reflectx"
+	"github.com/scylladb/gocqlx/v3"
+
+	"github.com/zeroflucs-given/charybdis/metadata"
+)
+
+// columnValues reads the values of the named columns from a record, using the session mapper
+// so that names resolve the same way they do when binding. Columns that can't be found on
+// the record yield nil.
+func (t *baseManagerImpl[T]) columnValues(instance *T, columns []string) []any {
+	return fieldValues(t.Session.Mapper, instance, columns)
+}
+
+// fieldValues reads the values of the named columns from a record using the given mapper.
+// Columns that can't be found on the record yield nil.
+func fieldValues[T any](mapper *reflectx.Mapper, instance *T, columns []string) []any {
+	result := make([]any, len(columns))
+	if instance == nil {
+		return result
+	}
+
+	v := reflect.ValueOf(instance).Elem()
+	traversals := mapper.TraversalsByName(v.Type(), columns)
+	for i, path := range traversals {
+		if len(path) == 0 {
+			continue
+		}
+		result[i] = reflectx.FieldByIndexesReadOnly(v, path).Interface()
+	}
+
+	return result
+}
+
+// managerMapper gets the mapper used by a manager's session, falling back to the default mapper
+// for managers that don't expose a gocqlx session.
+func managerMapper(session any) *reflectx.Mapper {
+	if sess, ok := session.(gocqlx.Session); ok && sess.Mapper != nil {
+		return sess.Mapper
+	}
+	return gocqlx.DefaultMapper
+}
+
+// keyProtoVersion is the protocol version keys are marshalled with. Native types marshal the same
+// under every version.
+const keyProtoVersion = 4
+
+// nativeKeyTypes are the CQL types whose values we marshal to build comparable keys
+var nativeKeyTypes = map[string]gocql.Type{
+	"ascii":     gocql.TypeAscii,
+	"bigint":    gocql.TypeBigInt,
+	"blob":      gocql.TypeBlob,
+	"boolean":   gocql.TypeBoolean,
+	"counter":   gocql.TypeCounter,
+	"date":      gocql.TypeDate,
+	"decimal":   gocql.TypeDecimal,
+	"double":    gocql.TypeDouble,
+	"duration":  gocql.TypeDuration,
+	"float":     gocql.TypeFloat,
+	"inet":      gocql.TypeInet,
+	"int":       gocql.TypeInt,
+	"smallint":  gocql.TypeSmallInt,
+	"text":      gocql.TypeText,
+	"time":      gocql.TypeTime,
+	"timestamp": gocql.TypeTimestamp,
+	"timeuuid":  gocql.TypeTimeUUID,
+	"tinyint":   gocql.TypeTinyInt,
+	"uuid":      gocql.TypeUUID,
+	"varchar":   gocql.TypeVarchar,
+	"varint":    gocql.TypeVarint,
+}
+
+// keyEncoder builds comparable keys from the values of a table's key columns. Values are marshalled
+// as they would be when bound to a query, so that keys given by callers match those read back from
+// records even where they print differently, such as times carrying a location or monotonic clock
+// reading. Values of other types, such as frozen collections, fall back to their printed form.
+type keyEncoder struct {
+	types []gocql.TypeInfo // Type of each column, or nil if it is not a native type
+}
+
+// newKeyEncoder creates an encoder for the named columns of a table
+func newKeyEncoder(spec *metadata.TableSpecification, columns []string) keyEncoder {
+	cqlTypes := make(map[string]string, len(spec.Columns))
+	for _, col := range spec.Columns {
+		cqlTypes[col.Name] = strings.ToLower(strings.TrimSpace(col.CQLType))
+	}
+
+	types := make([]gocql.TypeInfo, len(columns))
+	for i, col := range columns {
+		if typ, ok := nativeKeyTypes[cqlTypes[col]]; ok {
+			types[i] = gocql.NewNativeType(keyProtoVersion, typ)
+		}
+	}
+
+	return keyEncoder{types: types}
+}
+
+// encode builds a key from the values of the encoder's columns, or a prefix of them. Returns
+// ErrKeyType if a value can't be bound to its column.
+func (e keyEncoder) encode(values []any) (string, error) {
+	if len(values) > len(e.types) {
+		return "", fmt.Errorf("%w: expected at most %d key values, got %d", ErrKeyCount, len(e.types), len(values))
+	}
+
+	var sb strings.Builder
+	for i, v := range values {
+		if e.types[i] == nil {
+			if rv := reflect.ValueOf(v); rv.Kind() == reflect.Pointer && !rv.IsNil() {
+				v = rv.Elem().Interface()
+			}
+			s := fmt.Sprintf("%T:%v", v, v)
+			fmt.Fprintf(&sb, "%d:%s|", len(s), s)
+			continue
+		}
+
+		data, err := gocql.Marshal(e.types[i], v)
+		if err != nil {
+			return "", fmt.Errorf("%w: value %d: %w", ErrKeyType, i, err)
+		}
+		fmt.Fprintf(&sb, "%d:%s|", len(data), data)
+	}
+	return sb.String(), nil
+}
+
+// cloneRecord creates a deep copy of a record, so that callers can't mutate each other's state
+// through shared pointers, slices or maps. Unexported fields are copied shallowly.
+func cloneRecord[T any](instance *T) *T {
+	if instance == nil {
+		return nil
+	}
+
+	clone := new(T)
+	cloneValue(reflect.ValueOf(clone).Elem(), reflect.ValueOf(instance).Elem())
+	return clone
+}
+
+// cloneValue deep-copies src into dst, which must be settable and of the same type
+func cloneValue(dst, src reflect.Value) {
+	switch src.Kind() {
+	case reflect.Pointer:
+		if src.IsNil() {
+			return
+		}
+		ptr := reflect.New(src.Elem().Type())
+		cloneValue(ptr.Elem(), src.Elem())
+		dst.Set(ptr)
+	case reflect.Slice:
+		if src.IsNil() {
+			return
+		}
+		slice := reflect.MakeSlice(src.Type(), src.Len(), src.Len())
+		for i := 0; i < src.Len(); i++ {
+			cloneValue(slice.Index(i), src.Index(i))
+		}
+		dst.Set(slice)
+	case reflect.Map:
+		if src.IsNil() {
+			return
+		}
+		m := reflect.MakeMapWithSize(src.Type(), src.Len())
+		iter := src.MapRange()
+		for iter.Next() {
+			val := reflect.New(iter.Value().Type()).Elem()
+			cloneValue(val, iter.Value())
+			m.SetMapIndex(iter.Key(), val)
+		}
+		dst.Set(m)
+	case reflect.Struct:
+		dst.Set(src) // Covers unexported fields
+		for i := 0; i < src.NumField(); i++ {
+			if dst.Field(i).CanSet() {
+				cloneValue(dst.Field(i), src.Field(i))
+			}
+		}
+	default:
+		dst.Set(src)
+	}
+}
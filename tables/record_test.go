@@ -0,0 +1,47 @@
+package tables
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeroflucs-given/charybdis/metadata"
+)
+
+// TestKeyEncoder checks keys match when values bind the same way, however they print
+func TestKeyEncoder(t *testing.T) {
+	// Arrange
+	spec := &metadata.TableSpecification{
+		Name: "events",
+		Columns: []*metadata.ColumnSpecification{
+			{Name: "name", CQLType: "text"},
+			{Name: "sequence", CQLType: "bigint"},
+			{Name: "occurred_at", CQLType: "timestamp"},
+		},
+	}
+	encoder := newKeyEncoder(spec, []string{"name", "sequence", "occurred_at"})
+	stored := time.Date(2026, 3, 4, 5, 6, 7, 8_000_000, time.UTC)
+	now := time.Now() // Carries a monotonic clock reading
+	given := now.Add(stored.Sub(now)).In(time.FixedZone("UTC+10", 10*60*60))
+	name := "event"
+
+	// Act
+	storedKey, errStored := encoder.encode([]any{"event", int64(1), stored})
+	givenKey, errGiven := encoder.encode([]any{&name, 1, given})
+	otherKey, errOther := encoder.encode([]any{"event", int64(2), stored})
+	prefixKey, errPrefix := encoder.encode([]any{"event"})
+	_, errType := encoder.encode([]any{1, int64(1), stored})
+	_, errCount := encoder.encode([]any{"event", int64(1), stored, "extra"})
+
+	// Assert
+	require.NoError(t, errStored, "Should encode stored values")
+	require.NoError(t, errGiven, "Should encode given values")
+	require.Equal(t, storedKey, givenKey, "Should match values that bind the same way")
+	require.NoError(t, errOther, "Should encode other values")
+	require.NotEqual(t, storedKey, otherKey, "Should not match different values")
+	require.NoError(t, errPrefix, "Should encode a prefix of the columns")
+	require.NotEmpty(t, prefixKey, "Should build a key for a prefix")
+	require.ErrorIs(t, errType, ErrKeyType, "Should reject values that can't be bound to their column")
+	require.ErrorIs(t, errCount, ErrKeyCount, "Should reject too many values")
+}
@@ -0,0 +1,104 @@
+package tables
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+
+	"github.com/scylladb/go-reflectx"
+
+	"github.com/zeroflucs-given/charybdis/metadata"
+)
+
+// Repository wraps a table manager with type-checked keys, so that keys passed in the wrong order or
+// of the wrong type fail to compile rather than at runtime. K is a struct holding the full primary key,
+// and P a struct holding the partition key. Their fields are matched to columns by cql tags in the same
+// way as SelectAs, and must cover exactly the key columns of the table. For tables without clustering
+// columns, K and P may be the same type.
+type Repository[T any, K any, P any] struct {
+	manager      TableManager[T]
+	primaryKey   []string // Partition and clustering key columns, in order
+	partitionKey []string // Partition key columns, in order
+}
+
+// NewRepository creates a repository over a table manager, checking the key types match the table
+func NewRepository[T any, K any, P any](manager TableManager[T]) (*Repository[T, K, P], error) {
+	spec := manager.GetTableSpec()
+	md := spec.ToCQLX().Metadata()
+	primaryKey := slices.Concat(md.PartKey, md.SortKey)
+
+	if err := checkKeyType[K](spec, md.Name, primaryKey); err != nil {
+		return nil, err
+	}
+	if err := checkKeyType[P](spec, md.Name, md.PartKey); err != nil {
+		return nil, err
+	}
+
+	return &Repository[T, K, P]{
+		manager:      manager,
+		primaryKey:   primaryKey,
+		partitionKey: md.PartKey,
+	}, nil
+}
+
+// Manager gets the table manager behind the repository
+func (r *Repository[T, K, P]) Manager() TableManager[T] {
+	return r.manager
+}
+
+// Get gets a record by its primary key
+func (r *Repository[T, K, P]) Get(ctx context.Context, key K) (*T, error) {
+	return r.manager.GetByPrimaryKey(ctx, fieldValues(projectionMapper, &key, r.primaryKey)...)
+}
+
+// Delete removes a record by its primary key
+func (r *Repository[T, K, P]) Delete(ctx context.Context, key K) error {
+	return r.manager.DeleteByPrimaryKey(ctx, fieldValues(projectionMapper, &key, r.primaryKey)...)
+}
+
+// SelectPartition gets all records from a partition
+func (r *Repository[T, K, P]) SelectPartition(ctx context.Context, partition P, fn PageHandlerFn[T], opts ...QueryOption) error {
+	return r.manager.SelectByPartitionKey(ctx, fn, opts, fieldValues(projectionMapper, &partition, r.partitionKey)...)
+}
+
+// CountPartition gets the number of records in a partition
+func (r *Repository[T, K, P]) CountPartition(ctx context.Context, partition P) (int64, error) {
+	return r.manager.CountByPartitionKey(ctx, fieldValues(projectionMapper, &partition, r.partitionKey)...)
+}
+
+// checkKeyType checks the columns of a key type are exactly the given key columns, and that each field
+// can be bound to its column. Field types are checked by encoding their zero values as keys.
+func checkKeyType[K any](spec *metadata.TableSpecification, table string, keyColumns []string) error {
+	keyType := reflect.TypeFor[K]()
+	if keyType.Kind() != reflect.Struct {
+		return fmt.Errorf("%w: %v is not a struct", ErrKeyType, keyType)
+	}
+
+	columns := projectedColumns[K](projectionMapper)
+	for _, column := range keyColumns {
+		if !slices.Contains(columns, column) {
+			return fmt.Errorf("%w: %v has no field for key column %q of %s", ErrKeyType, keyType, column, table)
+		}
+	}
+	for _, column := range columns {
+		if !slices.Contains(keyColumns, column) {
+			return fmt.Errorf("%w: %v field %q is not a key column of %s", ErrKeyType, keyType, column, table)
+		}
+	}
+
+	zero := reflect.New(keyType).Elem()
+	for i, path := range projectionMapper.TraversalsByName(keyType, keyColumns) {
+		field := reflectx.FieldByIndexes(zero, path)
+		if field.Kind() == reflect.Pointer {
+			field = reflect.New(field.Type().Elem())
+		}
+
+		_, err := newKeyEncoder(spec, keyColumns[i:i+1]).encode([]any{field.Interface()})
+		if err != nil {
+			return fmt.Errorf("%w: %v field for key column %q of %s has type %v", ErrKeyType, keyType, keyColumns[i], table, field.Type())
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package tables_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeroflucs-given/charybdis/tables"
+)
+
+// OrderItemKey is the primary key of an OrderItem
+type OrderItemKey struct {
+	OrderID string `cql:"order_id"`
+	ItemID  string `cql:"item_id"`
+}
+
+// OrderItemPartition is the partition key of an OrderItem
+type OrderItemPartition struct {
+	OrderID string `cql:"order_id"`
+}
+
+// OrderItemNumericKey is a primary key of an OrderItem with the wrong field types
+type OrderItemNumericKey struct {
+	OrderID int `cql:"order_id"`
+	ItemID  int `cql:"item_id"`
+}
+
+// TestRepository checks records can be read and deleted using typed keys
+func TestRepository(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+	manager, err := tables.NewTableManager[OrderItem](ctx,
+		tables.WithCluster(testClusterConfig),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(OrderItemsTableSpec))
+	require.NoError(t, err, "Should not error starting up")
+	repo, err := tables.NewRepository[OrderItem, OrderItemKey, OrderItemPartition](manager)
+	require.NoError(t, err, "Should not error creating the repository")
+
+	// Arrange
+	for i := range 3 {
+		errInsert := manager.Insert(ctx, &OrderItem{OrderID: "repo-order-1", ItemID: fmt.Sprintf("item-%d", i), Quantity: i + 1})
+		require.NoError(t, errInsert, "Should not error inserting")
+	}
+	var selected []*OrderItem
+
+	// Act
+	got, errGet := repo.Get(ctx, OrderItemKey{OrderID: "repo-order-1", ItemID: "item-1"})
+	errSelect := repo.SelectPartition(ctx, OrderItemPartition{OrderID: "repo-order-1"}, func(ctx context.Context, records []*OrderItem, originalPagingState []byte, newPagingState []byte) (bool, error) {
+		selected = append(selected, records...)
+		return true, nil
+	})
+	errDelete := repo.Delete(ctx, OrderItemKey{OrderID: "repo-order-1", ItemID: "item-1"})
+	count, errCount := repo.CountPartition(ctx, OrderItemPartition{OrderID: "repo-order-1"})
+	deleted, errDeleted := repo.Get(ctx, OrderItemKey{OrderID: "repo-order-1", ItemID: "item-1"})
+
+	// Assert
+	require.NoError(t, errGet, "Should not error getting")
+	require.Equal(t, 2, got.Quantity, "Should get the row by key")
+	require.NoError(t, errSelect, "Should not error selecting")
+	require.Len(t, selected, 3, "Should select the whole partition")
+	require.NoError(t, errDelete, "Should not error deleting")
+	require.NoError(t, errCount, "Should not error counting")
+	require.Equal(t, int64(2), count, "Should count the remaining rows")
+	require.NoError(t, errDeleted, "Should not error getting the deleted row")
+	require.Nil(t, deleted, "Should not find the deleted row")
+}
+
+// TestRepositoryKeyTypes checks key types that don't match the table are rejected
+func TestRepositoryKeyTypes(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+	manager, err := tables.NewTableManager[OrderItem](ctx,
+		tables.WithCluster(testClusterConfig),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(OrderItemsTableSpec))
+	require.NoError(t, err, "Should not error starting up")
+
+	// Act
+	_, errMissing := tables.NewRepository[OrderItem, OrderItemPartition, OrderItemPartition](manager)
+	_, errExtra := tables.NewRepository[OrderItem, OrderItem, OrderItemPartition](manager)
+	_, errPartition := tables.NewRepository[OrderItem, OrderItemKey, OrderItemKey](manager)
+	_, errFieldType := tables.NewRepository[OrderItem, OrderItemNumericKey, OrderItemPartition](manager)
+
+	// Assert
+	require.ErrorIs(t, errMissing, tables.ErrKeyType, "Should reject a key missing a clustering column")
+	require.ErrorIs(t, errExtra, tables.ErrKeyType, "Should reject a key with non-key columns")
+	require.ErrorIs(t, errPartition, tables.ErrKeyType, "Should reject a partition key with clustering columns")
+	require.ErrorIs(t, errFieldType, tables.ErrKeyType, "Should reject key fields that can't be bound to their columns")
+}
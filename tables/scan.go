@@ -7,14 +7,11 @@ import (
 	"github.com/scylladb/gocqlx/v3/qb"
 )
 
-// Scan performs an interactive scan of the data in the table.
+// Scan performs an interactive scan of the data in the table. If the manager was created with
+// WithScanBypassCache, the scan bypasses the Scylla cache.
 func (t *baseManagerImpl[T]) Scan(ctx context.Context, fn PageHandlerFn[T], opts ...QueryOption) error {
 	return t.pageQueryInternal(ctx, func(ctx context.Context, sess gocqlx.Session) *gocqlx.Queryx {
-		builder := qb.Select(t.Table.Name())
-		for _, opt := range opts {
-			opt.applyToBuilder(builder)
-		}
-		stmt, params := builder.ToCql()
+		stmt, params := t.scanBuilder(opts...).ToCql()
 
 		query := sess.ContextQuery(ctx, stmt, params)
 
@@ -23,3 +20,15 @@ func (t *baseManagerImpl[T]) Scan(ctx context.Context, fn PageHandlerFn[T], opts
 		return query
 	}, fn, opts...)
 }
+
+// scanBuilder builds the select statement for a scan
+func (t *baseManagerImpl[T]) scanBuilder(opts ...QueryOption) *qb.SelectBuilder {
+	builder := qb.Select(t.Table.Name())
+	if t.scanBypassCache {
+		builder = builder.BypassCache()
+	}
+	for _, opt := range opts {
+		builder = opt.applyToBuilder(builder)
+	}
+	return builder
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -114,3 +115,101 @@ func TestScanSorted(t *testing.T) {
 	require.Equal(t, 25, scanCount, "Should have stopped at right scan iteration")
 	require.Equal(t, 250, recordCount, "Should have at  the number of records we expect")
 }
+
+// TestScanWithPrefetch checks a prefetching scan sees the same rows, reports timings and stops cleanly
+func TestScanWithPrefetch(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+	manager, err := tables.NewTableManager[Order](ctx,
+		tables.WithCluster(testClusterConfig),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(OrdersTableSpec))
+	require.NoError(t, err, "Should not error starting up")
+
+	// Arrange
+	orders := make([]*Order, 500)
+	for i := range orders {
+		orders[i] = &Order{
+			OrderID:         fmt.Sprintf("prefetch-scan-%d", i),
+			ShippingAddress: testAddress(i, "Prefetch Street", "Somerville"),
+		}
+	}
+	errBulk := manager.InsertBulk(ctx, orders, 4)
+	require.NoError(t, errBulk, "Should not error inserting")
+
+	plainCount := 0
+	errPlain := manager.Scan(ctx, func(ctx context.Context, records []*Order, pageState []byte, newPageState []byte) (bool, error) {
+		plainCount += len(records)
+		return true, nil
+	}, tables.WithPaging(50, nil))
+	require.NoError(t, errPlain, "Should not error scanning without prefetch")
+
+	// Act
+	var timings []tables.PageTiming
+	prefetchCount := 0
+	errScan := manager.Scan(ctx, func(ctx context.Context, records []*Order, pageState []byte, newPageState []byte) (bool, error) {
+		prefetchCount += len(records)
+		return true, nil
+	}, tables.WithPaging(50, nil), tables.WithPrefetch(3), tables.WithPageTiming(func(timing tables.PageTiming) {
+		timings = append(timings, timing)
+	}))
+
+	stopPages := 0
+	errStop := manager.Scan(ctx, func(ctx context.Context, records []*Order, pageState []byte, newPageState []byte) (bool, error) {
+		stopPages++
+		return false, nil
+	}, tables.WithPaging(50, nil), tables.WithPrefetch(3))
+
+	// Assert
+	require.NoError(t, errScan, "Should not error scanning with prefetch")
+	require.Equal(t, plainCount, prefetchCount, "Should see the same rows as a plain scan")
+	require.GreaterOrEqual(t, len(timings), 10, "Should report a timing for each page")
+	for i, timing := range timings {
+		require.Equal(t, i, timing.Page, "Timings should be reported in page order")
+	}
+	require.NoError(t, errStop, "Should not error stopping early")
+	require.Equal(t, 1, stopPages, "Should only handle one page when stopped")
+}
+
+// TestScanBypassCacheAndServerTimeouts checks the BYPASS CACHE and USING TIMEOUT clauses are accepted
+func TestScanBypassCacheAndServerTimeouts(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+	manager, err := tables.NewTableManager[Order](ctx,
+		tables.WithCluster(testClusterConfig),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(OrdersTableSpec),
+		tables.WithScanBypassCache())
+	require.NoError(t, err, "Should not error starting up")
+
+	// Arrange
+	obj := &Order{
+		OrderID:         "scan-bypass-1",
+		ShippingAddress: testAddress(1, "Bypass Street", "Somerville"),
+	}
+	errUpsert := manager.Upsert(ctx, obj, tables.WithServerTimeout(5*time.Second))
+	require.NoError(t, errUpsert, "Should not error upserting with a server timeout")
+
+	// Act
+	recordCount := 0
+	errScan := manager.Scan(ctx, func(ctx context.Context, records []*Order, pageState []byte, newPageState []byte) (bool, error) {
+		recordCount += len(records)
+		return true, nil
+	}, tables.WithServerTimeout(5*time.Second))
+
+	fetched, errGet := manager.GetUsingOptions(ctx,
+		tables.WithKey("order_id", "scan-bypass-1"),
+		tables.WithBypassCache(),
+		tables.WithServerTimeout(5*time.Second))
+
+	errDelete := manager.DeleteUsingOptions(ctx,
+		tables.WithDeletionKey("order_id", "scan-bypass-1"),
+		tables.WithServerTimeout(5*time.Second))
+
+	// Assert
+	require.NoError(t, errScan, "Should not error scanning")
+	require.GreaterOrEqual(t, recordCount, 1, "Should have at least one record in the scan")
+	require.NoError(t, errGet, "Should not error getting")
+	require.NotNil(t, fetched, "Should get object back")
+	require.NoError(t, errDelete, "Should not error deleting with a server timeout")
+}
@@ -3,6 +3,7 @@ package tables
 import (
 	"context"
 	"errors"
+	"slices"
 
 	"github.com/gocql/gocql"
 	"github.com/scylladb/gocqlx/v3"
@@ -14,63 +15,81 @@ import (
 // behaviour is to return the first record by clustering order.
 func (t *baseManagerImpl[T]) GetByPartitionKey(ctx context.Context, partitionKeys ...any) (*T, error) {
 	return returnWithTracing(ctx, t.Tracer, t.Name+"/GetByPartitionKey", t.TraceAttributes, t.DoTracing, func(ctx context.Context) (*T, error) {
-		var target T
-		stmt, params := t.basicQueryBuilder().Where(t.partitionKeyPredicates...).ToCql()
-		errQuery := t.Session.Query(stmt, params).WithContext(ctx).Bind(partitionKeys...).Get(&target)
-		return &target, errQuery
+		return returnWithBudget(ctx, t.startup, t.Name+"/GetByPartitionKey", t.timeouts.read, func(ctx context.Context) (*T, error) {
+			stmt, params := t.basicQueryBuilder().Where(t.partitionKeyPredicates...).ToCql()
+			return t.cache.read(ctx, cacheByPartitionKey, partitionKeys, func(ctx context.Context) (*T, error) {
+				return t.coalesceGet(ctx, t.coalesceKey(stmt, partitionKeys...), func(ctx context.Context) (*T, error) {
+					return t.getRecord(ctx, t.Session.Query(stmt, params).WithContext(ctx).Bind(partitionKeys...))
+				})
+			})
+		})
 	})
 }
 
 // GetByPrimaryKey gets a record by primary key, including both partitioning and any clustering keys
 func (t *baseManagerImpl[T]) GetByPrimaryKey(ctx context.Context, primaryKeys ...any) (*T, error) {
 	return returnWithTracing(ctx, t.Tracer, t.Name+"/GetByPrimaryKey", t.TraceAttributes, t.DoTracing, func(ctx context.Context) (*T, error) {
-		var target T
-		stmt, params := t.basicQueryBuilder().Where(t.allKeyPredicates...).ToCql()
-		errQuery := t.Session.Query(stmt, params).WithContext(ctx).Bind(primaryKeys...).Get(&target)
-		return &target, errQuery
+		return returnWithBudget(ctx, t.startup, t.Name+"/GetByPrimaryKey", t.timeouts.read, func(ctx context.Context) (*T, error) {
+			stmt, params := t.basicQueryBuilder().Where(t.allKeyPredicates...).ToCql()
+			return t.cache.read(ctx, cacheByPrimaryKey, primaryKeys, func(ctx context.Context) (*T, error) {
+				return t.coalesceGet(ctx, t.coalesceKey(stmt, primaryKeys...), func(ctx context.Context) (*T, error) {
+					return t.getRecord(ctx, t.Session.Query(stmt, params).WithContext(ctx).Bind(primaryKeys...))
+				})
+			})
+		})
 	})
 }
 
 // GetUsingOptions provides a method to fetch the first row found using QueryOptions to determine keys search & columns returned, etc
 func (t *baseManagerImpl[T]) GetUsingOptions(ctx context.Context, opts ...QueryOption) (*T, error) {
 	return returnWithTracing(ctx, t.Tracer, t.Name+"/GetUsingOptions", t.TraceAttributes, t.DoTracing, func(ctx context.Context) (*T, error) {
-		var target T
-		stmt, params := t.basicQueryBuilder(opts...).ToCql()
-		if t.Logger != nil {
-			t.Logger.Debug("get", zap.String("query", stmt), zap.Any("params", params))
-		}
-		errQuery := t.Session.Query(stmt, params).WithContext(ctx).Bind(t.bindings(opts...)...).Get(&target)
-		return &target, errQuery
+		return returnWithBudget(ctx, t.startup, t.Name+"/GetUsingOptions", budgetFor(t.timeouts.read, opts), func(ctx context.Context) (*T, error) {
+			stmt, params := t.basicQueryBuilder(opts...).ToCql()
+			if t.Logger != nil {
+				t.Logger.Debug("get", zap.String("query", stmt), zap.Any("params", params))
+			}
+			bindings := t.bindings(opts...)
+			return t.coalesceGet(ctx, coalesceBindingsKey(stmt, bindings...), func(ctx context.Context) (*T, error) {
+				return t.getRecord(ctx, t.Session.Query(stmt, params).WithContext(ctx).Bind(bindings...))
+			})
+		})
 	})
 }
 
 // GetByExample gets a single record, binding by example object with the key fields all set
 func (t *baseManagerImpl[T]) GetByExample(ctx context.Context, example *T) (*T, error) {
 	return returnWithTracing(ctx, t.Tracer, t.Name+"/GetByExample", t.TraceAttributes, t.DoTracing, func(ctx context.Context) (*T, error) {
-		var target T
-		stmt, params := t.basicQueryBuilder().Where(t.allKeyPredicates...).ToCql()
-		errQuery := t.Session.Query(stmt, params).WithContext(ctx).BindStruct(example).Get(&target)
-		return &target, errQuery
+		return returnWithBudget(ctx, t.startup, t.Name+"/GetByExample", t.timeouts.read, func(ctx context.Context) (*T, error) {
+			stmt, params := t.basicQueryBuilder().Where(t.allKeyPredicates...).ToCql()
+			// Only the key fields are bound, so the rest of the example doesn't change the read
+			keys := t.columnValues(example, slices.Concat(t.TableMetadata.PartKey, t.TableMetadata.SortKey))
+			return t.coalesceGet(ctx, t.coalesceKey(stmt, keys...), func(ctx context.Context) (*T, error) {
+				return t.getRecord(ctx, t.Session.Query(stmt, params).WithContext(ctx).BindStruct(example))
+			})
+		})
 	})
 }
 
 // GetByIndexedColumn gets the first record matching an index
 func (t *baseManagerImpl[T]) GetByIndexedColumn(ctx context.Context, columnName string, value any, opts ...QueryOption) (*T, error) {
 	return returnWithTracing(ctx, t.Tracer, t.Name+"/GetByIndexedColumn", t.TraceAttributes, t.DoTracing, func(ctx context.Context) (*T, error) {
-		var target T
-		stmt, params := t.basicQueryBuilder(opts...).Where(qb.Eq(columnName)).ToCql()
-		bindings := append(t.bindings(opts...), value)
+		return returnWithBudget(ctx, t.startup, t.Name+"/GetByIndexedColumn", budgetFor(t.timeouts.read, opts), func(ctx context.Context) (*T, error) {
+			stmt, params := t.basicQueryBuilder(opts...).Where(qb.Eq(columnName)).ToCql()
+			bindings := append(t.bindings(opts...), value)
 
-		errQuery := t.Session.Query(stmt, params).WithContext(ctx).Bind(bindings...).Get(&target)
-		if errors.Is(errQuery, gocql.ErrNotFound) {
-			return nil, nil
-		}
+			return t.coalesceGet(ctx, coalesceBindingsKey(stmt, bindings...), func(ctx context.Context) (*T, error) {
+				target, errQuery := t.getRecord(ctx, t.Session.Query(stmt, params).WithContext(ctx).Bind(bindings...))
+				if errors.Is(errQuery, gocql.ErrNotFound) {
+					return nil, nil
+				}
 
-		if errQuery != nil {
-			return nil, errQuery
-		}
+				if errQuery != nil {
+					return nil, errQuery
+				}
 
-		return &target, nil
+				return target, nil
+			})
+		})
 	})
 }
 
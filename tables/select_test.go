@@ -3,7 +3,9 @@ package tables_test
 import (
 	"context"
 	"fmt"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -442,3 +444,167 @@ func TestGetUsingOptions(t *testing.T) {
 //	require.NoError(t, errGet, "Should not error fetching")
 //	require.NotNil(t, fetched, "Should get our object back")
 //}
+
+// TestSelectRange checks we can select a window of clustering keys within a partition
+func TestSelectRange(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+	manager, err := tables.NewTableManager[OrderItem](ctx,
+		tables.WithCluster(testClusterConfig),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(OrderItemsTableSpec))
+	require.NoError(t, err, "Should not error starting up")
+
+	// Arrange
+	toInsert := make([]*OrderItem, 0, 10)
+	for item := range 10 {
+		toInsert = append(toInsert, &OrderItem{
+			OrderID:  "range-order-1",
+			ItemID:   fmt.Sprintf("range-item-%d", item),
+			Quantity: item,
+		})
+	}
+	errInsert := manager.InsertBulk(ctx, toInsert, -1)
+	require.NoError(t, errInsert, "Should not error inserting")
+
+	// Act
+	var itemIDs []string
+	errSelect := manager.SelectRange(ctx, func(ctx context.Context, records []*OrderItem, pageState []byte, newPageState []byte) (bool, error) {
+		for _, rec := range records {
+			itemIDs = append(itemIDs, rec.ItemID)
+		}
+		return true, nil
+	}, []any{"range-order-1"}, tables.Range{
+		From:          []any{"range-item-3"},
+		To:            []any{"range-item-6"},
+		FromInclusive: true,
+	})
+	errTooLong := manager.SelectRange(ctx, func(ctx context.Context, records []*OrderItem, pageState []byte, newPageState []byte) (bool, error) {
+		return true, nil
+	}, []any{"range-order-1"}, tables.Range{From: []any{"range-item-3", 3}})
+
+	// Assert
+	require.NoError(t, errSelect, "Should not error selecting")
+	require.Equal(t, []string{"range-item-3", "range-item-4", "range-item-5"}, itemIDs, "Should get the items in the range")
+	require.ErrorIs(t, errTooLong, tables.ErrInvalidRange, "Should reject ranges longer than the clustering key")
+}
+
+// TestGetWithReadCoalescing checks concurrent reads of the same row each get their own copy
+func TestGetWithReadCoalescing(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+	manager, err := tables.NewTableManager[OrderItem](ctx,
+		tables.WithCluster(testClusterConfig),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(OrderItemsTableSpec),
+		tables.WithReadCoalescing())
+	require.NoError(t, err, "Should not error starting up")
+
+	// Arrange
+	errInsert := manager.Insert(ctx, &OrderItem{OrderID: "coalesce-order-1", ItemID: "item-1", Quantity: 5})
+	require.NoError(t, errInsert, "Should not error inserting")
+
+	// Act
+	results := make([]*OrderItem, 50)
+	errs := make([]error, len(results))
+	var wg sync.WaitGroup
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i], errs[i] = manager.GetByPrimaryKey(ctx, "coalesce-order-1", "item-1")
+		}()
+	}
+	wg.Wait()
+
+	// Assert
+	for i, result := range results {
+		require.NoError(t, errs[i], "Should not error reading")
+		require.Equal(t, 5, result.Quantity, "Should read the row")
+		if i > 0 {
+			require.NotSame(t, results[0], result, "Each caller should get its own copy")
+		}
+	}
+}
+
+// TestGetWithCache checks cached reads are served without the database, and invalidated by writes
+func TestGetWithCache(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+	manager, err := tables.NewTableManager[OrderItem](ctx,
+		tables.WithCluster(testClusterConfig),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(OrderItemsTableSpec),
+		tables.WithCache(tables.NewLRUCache(100), time.Minute),
+		tables.WithNegativeCaching(time.Minute))
+	require.NoError(t, err, "Should not error starting up")
+
+	// Arrange
+	errInsert := manager.Insert(ctx, &OrderItem{OrderID: "cache-order-1", ItemID: "item-1", Quantity: 5})
+	require.NoError(t, errInsert, "Should not error inserting")
+
+	// Act
+	first, errFirst := manager.GetByPrimaryKey(ctx, "cache-order-1", "item-1")
+	second, errSecond := manager.GetByPrimaryKey(ctx, "cache-order-1", "item-1")
+	errUpsert := manager.Upsert(ctx, &OrderItem{OrderID: "cache-order-1", ItemID: "item-1", Quantity: 7})
+	third, errThird := manager.GetByPrimaryKey(ctx, "cache-order-1", "item-1")
+	missing, errMissing := manager.GetByPrimaryKey(ctx, "cache-order-1", "item-2")
+	missingAgain, errMissingAgain := manager.GetByPrimaryKey(ctx, "cache-order-1", "item-2")
+
+	// Assert
+	require.NoError(t, errFirst, "Should not error on the first read")
+	require.NoError(t, errSecond, "Should not error on the cached read")
+	require.NoError(t, errUpsert, "Should not error upserting")
+	require.NoError(t, errThird, "Should not error reading after the upsert")
+	require.Equal(t, 5, second.Quantity, "Should read the cached row")
+	require.NotSame(t, first, second, "Should return a copy to each caller")
+	require.Equal(t, 7, third.Quantity, "Should read the upserted row")
+	require.NoError(t, errMissing, "Should not error reading a missing row")
+	require.Nil(t, missing, "Should not find the missing row")
+	require.NoError(t, errMissingAgain, "Should not error reading a cached missing row")
+	require.Nil(t, missingAgain, "Should not find the cached missing row")
+	require.Equal(t, tables.CacheStats{Hits: 2, NegativeHits: 1, Misses: 3}, manager.CacheStats(), "Should count hits and misses")
+}
+
+// OrderItemQuantity is a projection of OrderItem
+type OrderItemQuantity struct {
+	ItemID   string `cql:"item_id"`
+	Quantity int    `cql:"quantity"`
+}
+
+// TestSelectAs checks rows can be read into a projection of the record type
+func TestSelectAs(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+	manager, err := tables.NewTableManager[OrderItem](ctx,
+		tables.WithCluster(testClusterConfig),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(OrderItemsTableSpec))
+	require.NoError(t, err, "Should not error starting up")
+
+	// Arrange
+	for i := range 3 {
+		errInsert := manager.Insert(ctx, &OrderItem{OrderID: "project-order-1", ItemID: fmt.Sprintf("item-%d", i), Quantity: i + 1})
+		require.NoError(t, errInsert, "Should not error inserting")
+	}
+	var selected []*OrderItemQuantity
+
+	// Act
+	errSelect := tables.SelectAs(ctx, manager, func(ctx context.Context, records []*OrderItemQuantity, originalPagingState []byte, newPagingState []byte) (bool, error) {
+		selected = append(selected, records...)
+		return true, nil
+	}, tables.WithColumnsEqual("order_id"), tables.WithBindings("project-order-1"))
+	got, errGet := tables.GetAs[OrderItem, OrderItemQuantity](ctx, manager, tables.WithColumnsEqual("order_id", "item_id"), tables.WithBindings("project-order-1", "item-2"))
+	_, errUnknown := tables.GetAs[OrderItem, struct {
+		Price float64 `cql:"price"`
+	}](ctx, manager)
+
+	// Assert
+	require.NoError(t, errSelect, "Should not error selecting")
+	require.Len(t, selected, 3, "Should select every row of the partition")
+	require.Equal(t, "item-0", selected[0].ItemID, "Should decode the clustering key")
+	require.Equal(t, 1, selected[0].Quantity, "Should decode the quantity")
+	require.NoError(t, errGet, "Should not error getting")
+	require.Equal(t, &OrderItemQuantity{ItemID: "item-2", Quantity: 3}, got, "Should get the row")
+	require.ErrorIs(t, errUnknown, tables.ErrUnknownColumn, "Should reject columns missing from the table")
+}
@@ -0,0 +1,89 @@
+package tables
+
+import (
+	"sync"
+	"time"
+
+	"github.com/scylladb/gocqlx/v3"
+
+	"github.com/zeroflucs-given/charybdis/utils"
+)
+
+// SessionPool shares sessions between managers, so that a service with many tables doesn't open a connection
+// pool for each of them. A session is created for each keyspace when first acquired, and closed once every
+// manager using it has released it. The empty keyspace gives a session not bound to any keyspace, which the
+// generator startup hooks use for DDL. It stays open until the pool is closed.
+type SessionPool struct {
+	cluster  utils.ClusterConfigGeneratorFn
+	timeout  time.Duration
+	mu       sync.Mutex
+	sessions map[string]*pooledSession
+}
+
+// pooledSession is a session shared by a number of managers
+type pooledSession struct {
+	session gocqlx.Session
+	refs    int
+}
+
+// NewSessionPool creates a pool of sessions connecting to the given cluster
+func NewSessionPool(cluster utils.ClusterConfigGeneratorFn) *SessionPool {
+	return &SessionPool{
+		cluster:  cluster,
+		timeout:  cluster().Timeout,
+		sessions: map[string]*pooledSession{},
+	}
+}
+
+// Acquire gets the session for a keyspace, creating it if needed. Each call must be paired with a call
+// to Release.
+func (p *SessionPool) Acquire(keyspace string) (gocqlx.Session, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	pooled, ok := p.sessions[keyspace]
+	if !ok {
+		clusterVal := p.cluster()
+		clusterVal.Keyspace = keyspace
+		session, err := gocqlx.WrapSession(clusterVal.CreateSession())
+		if err != nil {
+			return gocqlx.Session{}, err
+		}
+
+		pooled = &pooledSession{session: session}
+		p.sessions[keyspace] = pooled
+	}
+
+	pooled.refs++
+	return pooled.session, nil
+}
+
+// Release gives up a session acquired for a keyspace, closing it if nothing else is using it. The session
+// for the empty keyspace is left open until the pool is closed.
+func (p *SessionPool) Release(keyspace string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	pooled, ok := p.sessions[keyspace]
+	if !ok {
+		return
+	}
+
+	// The unbound session is used in bursts by startup hooks, so is kept until the pool is closed
+	pooled.refs--
+	if pooled.refs <= 0 && keyspace != "" {
+		delete(p.sessions, keyspace)
+		pooled.session.Close()
+	}
+}
+
+// Close closes every session in the pool, whether or not it has been released
+func (p *SessionPool) Close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for keyspace, pooled := range p.sessions {
+		delete(p.sessions, keyspace)
+		pooled.session.Close()
+	}
+}
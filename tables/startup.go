@@ -0,0 +1,172 @@
+package tables
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// StartupRetryPolicy controls how manager startup is retried when the session can't be created or
+// the startup hooks fail. Backoff doubles after each failed attempt, up to MaxBackoff.
+type StartupRetryPolicy struct {
+	MaxAttempts    int           // Attempts before giving up, zero or less to keep trying
+	InitialBackoff time.Duration // Delay before the first retry, DefaultStartupInitialBackoff if not set
+	MaxBackoff     time.Duration // Cap on the delay between retries, DefaultStartupMaxBackoff if not set
+}
+
+// startupFn starts a manager, returning a function that releases anything it acquired once the manager is closed
+type startupFn func(ctx context.Context) (func(), error)
+
+// startupState tracks the lifecycle of a manager, from startup until it is closed. A nil state is always ready.
+type startupState struct {
+	done    chan struct{}      // Closed once startup has succeeded or given up
+	err     error              // Why startup gave up, only set before done is closed
+	release func()             // Releases what startup acquired, only set before done is closed
+	cancel  context.CancelFunc // Stops a background startup
+	closed  atomic.Bool        // The manager has been closed
+}
+
+// runStartup runs the startup function, retrying according to the policy. Lazy startups run in the
+// background and return straight away. Otherwise, any error from the last attempt is returned.
+func runStartup(ctx context.Context, logger *zap.Logger, lazy bool, policy *StartupRetryPolicy, start startupFn) (*startupState, error) {
+	state := &startupState{
+		done:   make(chan struct{}),
+		cancel: func() {},
+	}
+
+	if lazy {
+		if policy == nil {
+			policy = &StartupRetryPolicy{}
+		}
+
+		// Startup outlives the call that created the manager, but stops if the manager is closed
+		startCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		state.cancel = cancel
+
+		go func() {
+			defer close(state.done)
+			state.release, state.err = retryStartup(startCtx, logger, policy, start)
+			if state.err != nil {
+				logger.Error("manager startup failed", zap.Error(state.err))
+			}
+		}()
+		return state, nil
+	}
+
+	if policy == nil {
+		policy = &StartupRetryPolicy{MaxAttempts: 1}
+	}
+
+	defer close(state.done)
+	state.release, state.err = retryStartup(ctx, logger, policy, start)
+	return state, state.err
+}
+
+// retryStartup calls the startup function until it succeeds, the policy gives up or the context ends
+func retryStartup(ctx context.Context, logger *zap.Logger, policy *StartupRetryPolicy, start startupFn) (func(), error) {
+	backoff := policy.InitialBackoff
+	if backoff <= 0 {
+		backoff = DefaultStartupInitialBackoff
+	}
+	maxBackoff := policy.MaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = DefaultStartupMaxBackoff
+	}
+
+	for attempt := 1; ; attempt++ {
+		release, err := start(ctx)
+		if err == nil {
+			return release, nil
+		}
+		if policy.MaxAttempts > 0 && attempt >= policy.MaxAttempts {
+			return nil, err
+		}
+
+		// Jitter our delay, so that instances starting together don't retry in lock-step
+		delay := backoff/2 + rand.N(backoff/2+1)
+		logger.Warn("manager startup failed, retrying",
+			zap.Int("attempt", attempt),
+			zap.Duration("delay", delay),
+			zap.Error(err),
+		)
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("%w: last error: %w", ctx.Err(), err)
+		case <-time.After(delay):
+		}
+
+		backoff = min(backoff*2, maxBackoff)
+	}
+}
+
+// wait blocks until startup has completed, returning ErrNotReady if startup gave up or the context
+// ended first, or ErrManagerClosed if the manager has been closed
+func (s *startupState) wait(ctx context.Context) error {
+	if s == nil {
+		return nil
+	}
+	if s.closed.Load() {
+		return ErrManagerClosed
+	}
+
+	select {
+	case <-s.done:
+	default:
+		select {
+		case <-s.done:
+		case <-ctx.Done():
+			return fmt.Errorf("%w: %w", ErrNotReady, ctx.Err())
+		}
+	}
+
+	if s.err != nil {
+		return fmt.Errorf("%w: %w", ErrNotReady, s.err)
+	}
+	return nil
+}
+
+// isReady checks if startup has succeeded, without blocking
+func (s *startupState) isReady() bool {
+	if s == nil {
+		return true
+	}
+
+	select {
+	case <-s.done:
+		return s.err == nil && !s.closed.Load()
+	default:
+		return false
+	}
+}
+
+// close stops any startup still in progress, then releases anything startup acquired. Only the first
+// call has any effect.
+func (s *startupState) close() {
+	if s == nil || !s.closed.CompareAndSwap(false, true) {
+		return
+	}
+
+	s.cancel()
+	<-s.done
+	if s.release != nil {
+		s.release()
+	}
+}
+
+// Ready blocks until the manager has started, returning ErrNotReady if startup failed or the context ended
+// first. Managers that don't use lazy startup are always ready. This suits readiness probes.
+func (t *baseManagerImpl[T]) Ready(ctx context.Context) error {
+	return t.startup.wait(ctx)
+}
+
+// Close stops any startup still in progress and releases the manager's session. Sessions from a
+// SessionPool are closed once every manager using them is closed, and sessions passed in with
+// WithSession are left open. Operations on a closed manager return ErrManagerClosed.
+func (t *baseManagerImpl[T]) Close() {
+	t.startup.close()
+}
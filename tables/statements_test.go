@@ -0,0 +1,72 @@
+package tables
+
+import (
+	"testing"
+	"time"
+
+	"github.com/scylladb/gocqlx/v3/qb"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeroflucs-given/charybdis/metadata"
+)
+
+// statementRecord is a record type for statement tests
+type statementRecord struct {
+	ID   string `cql:"id"`
+	Name string `cql:"name"`
+}
+
+// newStatementManager creates a manager that can build statements, but not run them
+func newStatementManager(scanBypassCache bool) *baseManagerImpl[statementRecord] {
+	id := &metadata.ColumnSpecification{Name: "id", CQLType: "text", IsPartitioningKey: true}
+	spec := &metadata.TableSpecification{
+		Name:         "statements",
+		Columns:      []*metadata.ColumnSpecification{id, {Name: "name", CQLType: "text"}},
+		Partitioning: []*metadata.PartitioningColumn{{Column: id, Order: 1}},
+	}
+
+	return &baseManagerImpl[statementRecord]{
+		Table:           spec.ToCQLX(),
+		scanBypassCache: scanBypassCache,
+	}
+}
+
+// TestBypassCacheStatements checks BYPASS CACHE is added to queries and scans that ask for it
+func TestBypassCacheStatements(t *testing.T) {
+	// Arrange
+	plain := newStatementManager(false)
+	bypassing := newStatementManager(true)
+
+	// Act
+	query, _ := plain.basicQueryBuilder(WithKey("id", "1"), WithBypassCache()).ToCql()
+	plainQuery, _ := plain.basicQueryBuilder(WithKey("id", "1")).ToCql()
+	scan, _ := bypassing.scanBuilder().ToCql()
+	plainScan, _ := plain.scanBuilder().ToCql()
+
+	// Assert
+	require.Contains(t, query, "BYPASS CACHE", "Query should bypass the cache with WithBypassCache")
+	require.NotContains(t, plainQuery, "BYPASS CACHE", "Query should use the cache by default")
+	require.Contains(t, scan, "BYPASS CACHE", "Scan should bypass the cache with WithScanBypassCache")
+	require.NotContains(t, plainScan, "BYPASS CACHE", "Scan should use the cache by default")
+}
+
+// TestServerTimeoutStatements checks WithServerTimeout adds USING TIMEOUT to every kind of statement
+func TestServerTimeoutStatements(t *testing.T) {
+	// Arrange
+	manager := newStatementManager(false)
+	opt := WithServerTimeout(5 * time.Second)
+
+	// Act
+	selectStmt, _ := manager.basicQueryBuilder(WithKey("id", "1"), opt).ToCql()
+	scanStmt, _ := manager.scanBuilder(opt).ToCql()
+	insertStmt, _ := opt.applyToInsertBuilder(qb.Insert("statements").Columns("id", "name")).ToCql()
+	updateStmt, _ := opt.applyToUpdateBuilder(qb.Update("statements").Set("name").Where(qb.Eq("id")).TTLNamed(rowTTLBindingName)).ToCql()
+	deleteStmt, _ := opt.applyToDeleteBuilder(qb.Delete("statements").Where(qb.Eq("id"))).ToCql()
+
+	// Assert
+	require.Contains(t, selectStmt, "USING TIMEOUT 5s", "Select should carry the timeout")
+	require.Contains(t, scanStmt, "USING TIMEOUT 5s", "Scan should carry the timeout")
+	require.Contains(t, insertStmt, "USING TIMEOUT 5s", "Insert should carry the timeout")
+	require.Contains(t, updateStmt, "USING TTL ? AND TIMEOUT 5s", "Update should carry the timeout alongside the TTL")
+	require.Contains(t, deleteStmt, "USING TIMEOUT 5s", "Delete should carry the timeout")
+}
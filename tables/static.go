@@ -0,0 +1,128 @@
+package tables
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/scylladb/gocqlx/v3/qb"
+	"go.uber.org/zap"
+)
+
+// GetStatic gets the static columns of a partition. Only the partition keys and static columns
+// will be populated in the result.
+func (t *tableManagerImpl[T]) GetStatic(ctx context.Context, partitionKeys ...any) (*T, error) {
+	return returnWithTracing(ctx, t.Tracer, t.Name+"/GetStatic", t.TraceAttributes, t.DoTracing, func(ctx context.Context) (*T, error) {
+		return returnWithBudget(ctx, t.startup, t.Name+"/GetStatic", t.timeouts.read, func(ctx context.Context) (*T, error) {
+			if len(t.staticColumns) == 0 {
+				return nil, ErrNoStaticColumns
+			}
+
+			stmt, params := qb.Select(t.Table.Name()).
+				Distinct(slices.Concat(t.TableMetadata.PartKey, t.staticColumns)...).
+				Where(t.partitionKeyPredicates...).
+				ToCql()
+			return t.coalesceGet(ctx, t.coalesceKey(stmt, partitionKeys...), func(ctx context.Context) (*T, error) {
+				return t.getRecord(ctx, t.Session.Query(stmt, params).WithContext(ctx).Consistency(t.readConsistency).Bind(partitionKeys...))
+			})
+		})
+	})
+}
+
+// UpsertStatic overwrites the static columns of a partition. Only the partition keys and static
+// columns need be present in the instance. Returns ErrPreconditionFailed if a condition given in
+// the options did not hold.
+func (t *tableManagerImpl[T]) UpsertStatic(ctx context.Context, instance *T, opts ...UpsertOption) error {
+	// Static columns are read with every row of the partition
+	defer t.cache.invalidateAll()
+
+	return doWithTracing(ctx, t.Tracer, t.Name+"/UpsertStatic", t.TraceAttributes, t.DoTracing, func(ctx context.Context) error {
+		if len(t.staticColumns) == 0 {
+			return ErrNoStaticColumns
+		}
+
+		// Lifecycle methods and pre-change hooks
+		errPre := t.prepareUpdate(ctx, instance)
+		if errPre != nil {
+			return errPre
+		}
+
+		// Static columns are addressed by the partition key alone
+		builder := qb.Update(t.qualifiedTableName).
+			Set(t.staticColumns...).
+			Where(t.partitionKeyPredicates...)
+
+		additionalVals := map[string]any{}
+
+		skipNil := false
+		isLWT := false
+
+		for _, opt := range opts {
+			isLWT = isLWT || opt.isPrecondition()
+			builder = opt.applyToUpdateBuilder(builder)
+			maps.Copy(additionalVals, opt.getMapData())
+			skipNil = skipNil || opt.skipsNilColumns()
+		}
+
+		budget := budgetFor(t.timeouts.forWrite(isLWT), opts)
+		return doWithBudget(ctx, t.startup, t.Name+"/UpsertStatic", budget, func(retryCtx context.Context) error {
+			st := time.Now()
+
+			query := builder.QueryContext(retryCtx, t.Session).
+				Consistency(t.writeConsistency).
+				WithBindTransformer(t.unsetTransformer(skipNil)).
+				BindStructMap(instance, additionalVals)
+
+			defer query.Release()
+			queryString := query.String()
+			t.Logger.Debug("upsert static columns by partition key", zap.String("query", queryString))
+
+			if isLWT {
+				// The row can't be read back to resolve a timeout without a full primary key, so any
+				// timeout leaves the outcome unknown
+				applied, err := t.faultyExecCAS(retryCtx, OperationUpsert, query.ExecCAS)()
+				var wto *gocql.RequestErrWriteTimeout
+				var casUnknown *gocql.RequestErrCASWriteUnknown
+				if errors.As(err, &wto) || errors.As(err, &casUnknown) {
+					return fmt.Errorf("%w: upsert static: %w", ErrOutcomeUnknown, err)
+				}
+				if err != nil {
+					return err
+				}
+				if !applied {
+					return ErrPreconditionFailed
+				}
+			} else {
+				exec := t.faultyExec(retryCtx, OperationUpsert, query.Exec)
+				for {
+					err := exec()
+					if err == nil {
+						break
+					}
+
+					var wto *gocql.RequestErrWriteTimeout
+					retryable := errors.As(err, &wto)
+					if !retryable {
+						return err
+					}
+
+					t.Logger.Debug("upsert static retrying from early write timeout",
+						zap.String("consistency", wto.Consistency.String()),
+						zap.Int("received", wto.Received),
+						zap.Int("blockFor", wto.BlockFor),
+						zap.String("writeType", wto.WriteType),
+						zap.Duration("set_timeout", budget),
+						zap.Duration("execution_time_to_now", time.Since(st)),
+					)
+				}
+			}
+
+			// Post-change hooks
+			return t.runPostHooks(ctx, instance)
+		})
+	})
+}
@@ -0,0 +1,115 @@
+package tables_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeroflucs-given/charybdis/tables"
+)
+
+// TestStaticColumns checks that static columns are shared across a partition, and can be
+// written and read at partition level
+func TestStaticColumns(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+	manager, err := tables.NewTableManager[MarketSelection](ctx,
+		tables.WithCluster(testClusterConfig),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(MarketSelectionsTableSpec))
+	require.NoError(t, err, "Should not error starting up")
+
+	// Arrange
+	errInsert := manager.InsertBulk(ctx, []*MarketSelection{
+		{
+			MarketID:    "static-market-1",
+			SelectionID: "home",
+			MarketName:  "Original Name",
+			Price:       1.5,
+		},
+		{
+			MarketID:    "static-market-1",
+			SelectionID: "away",
+			MarketName:  "Original Name",
+			Price:       2.5,
+		},
+	}, -1)
+	require.NoError(t, errInsert, "Should not error inserting")
+
+	// Act
+	errStatic := manager.UpsertStatic(ctx, &MarketSelection{
+		MarketID:   "static-market-1",
+		MarketName: "Updated Name",
+	})
+	require.NoError(t, errStatic, "Should not error upserting static columns")
+
+	// Assert
+	header, errHeader := manager.GetStatic(ctx, "static-market-1")
+	require.NoError(t, errHeader, "Should not error fetching static columns")
+	require.NotNil(t, header, "Should get a header back")
+	require.Equal(t, "Updated Name", header.MarketName, "Should have the updated static value")
+
+	row, errRow := manager.GetByPrimaryKey(ctx, "static-market-1", "away")
+	require.NoError(t, errRow, "Should not error fetching row")
+	require.NotNil(t, row, "Should get row back")
+	require.Equal(t, "Updated Name", row.MarketName, "Static value should be shared by all rows")
+	require.Equal(t, 2.5, row.Price, "Should not touch non-static columns")
+}
+
+// TestUpsertStaticPrecondition checks a failed condition on the static columns is reported
+func TestUpsertStaticPrecondition(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+	manager, err := tables.NewTableManager[MarketSelection](ctx,
+		tables.WithCluster(testClusterConfig),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(MarketSelectionsTableSpec))
+	require.NoError(t, err, "Should not error starting up")
+
+	// Arrange
+	errInsert := manager.Insert(ctx, &MarketSelection{
+		MarketID:    "static-market-2",
+		SelectionID: "home",
+		MarketName:  "Original Name",
+		Price:       1.5,
+	})
+	require.NoError(t, errInsert, "Should not error inserting")
+
+	// Act
+	errFailed := manager.UpsertStatic(ctx, &MarketSelection{
+		MarketID:   "static-market-2",
+		MarketName: "Failed Name",
+	}, tables.WithSimpleUpsertIf("market_name", "Some Other Name"))
+	errApplied := manager.UpsertStatic(ctx, &MarketSelection{
+		MarketID:   "static-market-2",
+		MarketName: "Applied Name",
+	}, tables.WithSimpleUpsertIf("market_name", "Original Name"))
+
+	// Assert
+	require.ErrorIs(t, errFailed, tables.ErrPreconditionFailed, "Should report the failed condition")
+	require.NoError(t, errApplied, "Should not error when the condition holds")
+	header, errHeader := manager.GetStatic(ctx, "static-market-2")
+	require.NoError(t, errHeader, "Should not error fetching static columns")
+	require.NotNil(t, header, "Should get a header back")
+	require.Equal(t, "Applied Name", header.MarketName, "Should only apply the write whose condition held")
+}
+
+// TestStaticColumnsRequireStaticSpec checks partition level operations are refused without static columns
+func TestStaticColumnsRequireStaticSpec(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+	manager, err := tables.NewTableManager[OrderItem](ctx,
+		tables.WithCluster(testClusterConfig),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(OrderItemsTableSpec))
+	require.NoError(t, err, "Should not error starting up")
+
+	// Act
+	_, errGet := manager.GetStatic(ctx, "static-order-1")
+	errUpsert := manager.UpsertStatic(ctx, &OrderItem{OrderID: "static-order-1"})
+
+	// Assert
+	require.ErrorIs(t, errGet, tables.ErrNoStaticColumns)
+	require.ErrorIs(t, errUpsert, tables.ErrNoStaticColumns)
+}
@@ -3,6 +3,8 @@ package tables
 import (
 	"context"
 	"fmt"
+	"slices"
+	"time"
 
 	"github.com/gocql/gocql"
 	"github.com/scylladb/gocqlx/v3"
@@ -37,36 +39,31 @@ func NewTableManager[T any](ctx context.Context, options ...ManagerOption) (Tabl
 		})
 	}
 
-	// Execute hooks
-	for _, opt := range options {
-		err := opt.onStart(
-			ctx,
-			params.Keyspace,
-			WithTableSpec(params.TableSpec),
-			WithViewSpec(params.ViewSpec),
-			WithTypeSpec(params.TypeSpecs...),
-			WithAdditionalDDL(extraOps...),
-		)
-		if err != nil {
-			return nil, fmt.Errorf("running table manager start hooks: %w", err)
-		}
-	}
-
 	// Validate table spec
 	errTable := params.TableSpec.Validate()
 	if errTable != nil {
 		return nil, fmt.Errorf("validating table spec: %w", errTable)
 	}
 
-	// Create our session
-	wrappedSession, err := gocqlx.WrapSession(params.SessionFactory(params.Keyspace))
-	if err != nil {
-		return nil, fmt.Errorf("wrapping session: %w", err)
+	// Look for a per-row TTL field on our record type
+	ttlField, errTTL := findRowTTLField[T]()
+	if errTTL != nil {
+		return nil, fmt.Errorf("detecting row TTL field: %w", errTTL)
+	}
+
+	limiter := params.Limiter
+	if limiter == nil {
+		limiter = NewAdaptiveLimiter()
 	}
 
 	table := params.TableSpec.ToCQLX()
+	nonKeyColumns := generics.Map(generics.Filter(params.TableSpec.Columns, func(i int, c *metadata.ColumnSpecification) bool {
+		return !c.IsPartitioningKey && !c.IsClusteringKey
+	}), func(i int, c *metadata.ColumnSpecification) string {
+		return c.Name
+	})
 
-	return &tableManagerImpl[T]{
+	mgr := &tableManagerImpl[T]{
 		baseManagerImpl: baseManagerImpl[T]{
 			// Base objects
 			Logger: params.Logger.With(
@@ -77,7 +74,7 @@ func NewTableManager[T any](ctx context.Context, options ...ManagerOption) (Tabl
 
 			// Metadata
 			Name:          params.TableSpec.Name,
-			Session:       wrappedSession,
+			Session:       gocqlx.Session{Mapper: params.mapper()}, // Connected during startup
 			Table:         table,
 			TableMetadata: table.Metadata(),
 
@@ -85,11 +82,8 @@ func NewTableManager[T any](ctx context.Context, options ...ManagerOption) (Tabl
 			readConsistency:    params.ReadConsistency,
 			qualifiedTableName: params.Keyspace + "." + params.TableSpec.Name,
 			allColumnNames:     table.Metadata().Columns,
-			nonKeyColumns: generics.Map(generics.Filter(params.TableSpec.Columns, func(i int, c *metadata.ColumnSpecification) bool {
-				return !c.IsPartitioningKey && !c.IsClusteringKey
-			}), func(i int, c *metadata.ColumnSpecification) string {
-				return c.Name
-			}),
+			lifecycle:          newRecordLifecycle[T](),
+			nonKeyColumns:      nonKeyColumns,
 			partitionKeyPredicates: generics.Map(params.TableSpec.Partitioning, func(i int, c *metadata.PartitioningColumn) qb.Cmp {
 				return qb.Eq(c.Column.Name)
 			}),
@@ -98,12 +92,82 @@ func NewTableManager[T any](ctx context.Context, options ...ManagerOption) (Tabl
 			}), func(i int, c *metadata.ColumnSpecification) qb.Cmp {
 				return qb.Eq(c.Name)
 			}),
-			queryTimeout: params.queryTimeout,
+			clustering:      params.TableSpec.Clustering,
+			scanBypassCache: params.ScanBypassCache,
+			timeouts:        params.Timeouts.withDefault(params.queryTimeout),
+			queries:         params.TableSpec.Queries,
+			faults:          params.FaultInjector,
 		},
 
 		tableSpec:        params.TableSpec,
 		writeConsistency: params.WriteConsistency,
-	}, nil
+		defaultTTL:       params.TTL,
+		rowTTL:           ttlField,
+		omitEmptyColumns: findOmitEmptyColumns[T](nonKeyColumns),
+		limiter:          limiter,
+		staticColumns: generics.Map(generics.Filter(params.TableSpec.Columns, func(i int, c *metadata.ColumnSpecification) bool {
+			return c.IsStatic
+		}), func(i int, c *metadata.ColumnSpecification) string {
+			return c.Name
+		}),
+	}
+
+	// Run startup hooks and create our session, which may happen in the background
+	startup, err := runStartup(ctx, mgr.Logger, params.LazyStartup, params.StartupRetry, func(ctx context.Context) (func(), error) {
+		// Execute hooks
+		for _, opt := range options {
+			err := opt.onStart(
+				ctx,
+				params.Keyspace,
+				WithTableSpec(params.TableSpec),
+				WithViewSpec(params.ViewSpec),
+				WithTypeSpec(params.TypeSpecs...),
+				WithAdditionalDDL(extraOps...),
+				WithStartupSessionPool(params.SessionPool),
+			)
+			if err != nil {
+				return nil, fmt.Errorf("running table manager start hooks: %w", err)
+			}
+		}
+
+		// Connect our session
+		session, release, err := params.openSession()
+		if err != nil {
+			return nil, fmt.Errorf("opening session: %w", err)
+		}
+		if errPrepare := prepareQueries(ctx, session, params.TableSpec.Queries); errPrepare != nil {
+			release()
+			return nil, errPrepare
+		}
+		mgr.Session.Session = session.Session
+		return release, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	mgr.startup = startup
+
+	mgr.keys = newKeyEncoder(params.TableSpec, slices.Concat(mgr.TableMetadata.PartKey, mgr.TableMetadata.SortKey))
+	if params.ReadCoalescing {
+		mgr.coalescer = newReadCoalescer()
+	}
+	if params.Cache != nil {
+		mgr.cache = &recordCache[T]{
+			cache:       params.Cache,
+			prefix:      mgr.qualifiedTableName,
+			keys:        mgr.keys,
+			ttl:         params.CacheTTL,
+			negativeTTL: params.NegativeCacheTTL,
+		}
+	}
+
+	if verifier := params.SchemaVerifier; verifier != nil {
+		mgr.verifySchema = func(ctx context.Context, sess gocqlx.Session) ([]SchemaProblem, error) {
+			return verifier.VerifyTable(ctx, sess, params.Keyspace, params.TableSpec)
+		}
+	}
+
+	return mgr, nil
 }
 
 // tableManagerImpl is our underlying table manager implementation type. We make it private here
@@ -117,6 +181,11 @@ type tableManagerImpl[T any] struct {
 	preHooks         []ChangeHook[T]
 	postHooks        []ChangeHook[T]
 	writeConsistency gocql.Consistency // Write consistency
+	staticColumns    []string          // Static column names
+	defaultTTL       time.Duration     // Default TTL for rows, if any
+	rowTTL           *rowTTLField      // Field providing per-row TTLs, if any
+	omitEmptyColumns map[string]bool   // Non-key columns left unset when nil or empty
+	limiter          *AdaptiveLimiter  // Limiter for bulk operations run with Adaptive concurrency
 }
 
 // GetTableSpec gets the table specification we're using
@@ -125,5 +194,20 @@ func (t *tableManagerImpl[T]) GetTableSpec() *metadata.TableSpecification {
 }
 
 func (t *tableManagerImpl[T]) GetSession() any {
+	if !t.startup.isReady() {
+		return nil
+	}
 	return t.Session
 }
+
+// rowTTLBindings gets the additional bindings needed to apply a per-row TTL, if the
+// record type has one.
+func (t *tableManagerImpl[T]) rowTTLBindings(instance *T) map[string]any {
+	if t.rowTTL == nil {
+		return nil
+	}
+
+	return map[string]any{
+		rowTTLBindingName: t.rowTTL.bindingValue(instance, t.defaultTTL),
+	}
+}
@@ -0,0 +1,136 @@
+package tables
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gocql/gocql"
+)
+
+// TimeoutError describes an operation that ran out of time, either because its budget was spent or
+// because the caller's deadline passed first
+type TimeoutError struct {
+	Operation      string        // Operation that timed out
+	Budget         time.Duration // Budget the operation had, zero if it was only limited by the caller
+	CallerDeadline bool          // The caller's deadline had passed
+	Err            error         // Underlying error
+}
+
+// Error implements [error]
+func (e *TimeoutError) Error() string {
+	if e.CallerDeadline || e.Budget <= 0 {
+		return fmt.Sprintf("%s: caller deadline exceeded: %v", e.Operation, e.Err)
+	}
+	return fmt.Sprintf("%s: timed out after budget of %v: %v", e.Operation, e.Budget, e.Err)
+}
+
+// Unwrap gets the underlying error
+func (e *TimeoutError) Unwrap() error {
+	return e.Err
+}
+
+// Is allows errors.Is to match ErrTimeout
+func (e *TimeoutError) Is(target error) bool {
+	return target == ErrTimeout
+}
+
+// operationTimeouts are the budgets for each kind of operation. A budget of zero or less leaves the
+// caller's deadline as the only limit.
+type operationTimeouts struct {
+	read     time.Duration // Single row reads and counts
+	write    time.Duration // Writes and deletes, including any retries
+	lwt      time.Duration // Conditional writes, including any retries
+	scanPage time.Duration // Each page of a paged query
+}
+
+// withDefault fills any unset budgets with the given default
+func (o operationTimeouts) withDefault(d time.Duration) operationTimeouts {
+	for _, budget := range []*time.Duration{&o.read, &o.write, &o.lwt, &o.scanPage} {
+		if *budget <= 0 {
+			*budget = d
+		}
+	}
+	return o
+}
+
+// forWrite gets the budget for a write
+func (o operationTimeouts) forWrite(isLWT bool) time.Duration {
+	if isLWT {
+		return o.lwt
+	}
+	return o.write
+}
+
+// timeoutOption is implemented by options that can override the budget of a single call
+type timeoutOption interface {
+	timeoutBudget() time.Duration
+}
+
+// budgetFor gets the budget for a call, letting the last option that sets one override the default
+func budgetFor[O timeoutOption](budget time.Duration, opts []O) time.Duration {
+	for _, opt := range opts {
+		if d := opt.timeoutBudget(); d > 0 {
+			budget = d
+		}
+	}
+	return budget
+}
+
+// doWithBudget runs an operation once the manager has started, with a context whose deadline is the earlier
+// of the caller's deadline and the budget. Timeouts are reported as a *TimeoutError.
+func doWithBudget(ctx context.Context, startup *startupState, operation string, budget time.Duration, execute func(context.Context) error) error {
+	_, err := returnWithBudget(ctx, startup, operation, budget, func(ctx context.Context) (struct{}, error) {
+		return struct{}{}, execute(ctx)
+	})
+	return err
+}
+
+// returnWithBudget runs an operation once the manager has started, with a context whose deadline is the
+// earlier of the caller's deadline and the budget. Waiting for startup is bounded only by the caller's
+// context. Timeouts are reported as a *TimeoutError.
+func returnWithBudget[TResult any](ctx context.Context, startup *startupState, operation string, budget time.Duration, execute func(context.Context) (TResult, error)) (TResult, error) {
+	errReady := startup.wait(ctx)
+	if errReady != nil {
+		var dflt TResult
+		return dflt, errReady
+	}
+
+	budgetCtx, cancel := ctx, context.CancelFunc(func() {})
+	if budget > 0 {
+		budgetCtx, cancel = context.WithTimeout(ctx, budget)
+	}
+	defer cancel()
+
+	result, err := execute(budgetCtx)
+	if err == nil || !isTimeout(budgetCtx, err) {
+		return result, err
+	}
+
+	var timeoutErr *TimeoutError
+	if errors.As(err, &timeoutErr) {
+		return result, err // Already reported by a nested operation
+	}
+
+	return result, &TimeoutError{
+		Operation:      operation,
+		Budget:         budget,
+		CallerDeadline: errors.Is(ctx.Err(), context.DeadlineExceeded),
+		Err:            err,
+	}
+}
+
+// isTimeout checks if an error was caused by running out of time
+func isTimeout(ctx context.Context, err error) bool {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return true
+	}
+
+	var wto *gocql.RequestErrWriteTimeout
+	var rto *gocql.RequestErrReadTimeout
+	return errors.Is(err, context.DeadlineExceeded) ||
+		errors.Is(err, gocql.ErrTimeoutNoResponse) ||
+		errors.As(err, &wto) ||
+		errors.As(err, &rto)
+}
@@ -0,0 +1,96 @@
+package tables_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeroflucs-given/charybdis/tables"
+)
+
+// TestOperationTimeouts checks operations that exceed their budget report a TimeoutError
+func TestOperationTimeouts(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+	manager, err := tables.NewTableManager[Order](ctx,
+		tables.WithCluster(testClusterConfig),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(OrdersTableSpec),
+		tables.WithOperationTimeouts(time.Nanosecond, time.Nanosecond, time.Nanosecond, time.Nanosecond))
+	require.NoError(t, err, "Should not error starting up")
+
+	// Act
+	_, errGet := manager.GetByPartitionKey(ctx, "timeout-test-1")
+	errUpsert := manager.Upsert(ctx, &Order{OrderID: "timeout-test-1"})
+	errScan := manager.Scan(ctx, func(ctx context.Context, records []*Order, _ []byte, _ []byte) (bool, error) {
+		return true, nil
+	})
+
+	// Assert
+	require.ErrorIs(t, errGet, tables.ErrTimeout, "Read should time out")
+	require.ErrorIs(t, errUpsert, tables.ErrTimeout, "Write should time out")
+	require.ErrorIs(t, errScan, tables.ErrTimeout, "Scan page should time out")
+
+	var timeoutErr *tables.TimeoutError
+	require.True(t, errors.As(errGet, &timeoutErr), "Should get a TimeoutError")
+	require.Equal(t, time.Nanosecond, timeoutErr.Budget, "Should report the budget")
+	require.False(t, timeoutErr.CallerDeadline, "Caller deadline had not passed")
+}
+
+// TestOperationTimeoutOverrides checks per-call timeouts override the manager's budgets
+func TestOperationTimeoutOverrides(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+	manager, err := tables.NewTableManager[Order](ctx,
+		tables.WithCluster(testClusterConfig),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(OrdersTableSpec),
+		tables.WithOperationTimeouts(time.Nanosecond, time.Nanosecond, time.Nanosecond, time.Nanosecond))
+	require.NoError(t, err, "Should not error starting up")
+
+	// Act
+	errUpsert := manager.Upsert(ctx, &Order{
+		OrderID:         "timeout-test-2",
+		ShippingAddress: testAddress(1, "Patient Street", "Somerville"),
+	}, tables.WithOperationTimeout(10*time.Second))
+	fetched, errGet := manager.GetUsingOptions(ctx,
+		tables.WithKey("order_id", "timeout-test-2"),
+		tables.WithQueryTimeout(10*time.Second))
+	errDelete := manager.DeleteUsingOptions(ctx,
+		tables.WithDeletionKey("order_id", "timeout-test-2"),
+		tables.WithDeleteTimeout(10*time.Second))
+
+	// Assert
+	require.NoError(t, errUpsert, "Should not error upserting with a longer budget")
+	require.NoError(t, errGet, "Should not error fetching with a longer budget")
+	require.NotNil(t, fetched, "Should get object back")
+	require.NoError(t, errDelete, "Should not error deleting with a longer budget")
+}
+
+// TestOperationTimeoutCallerDeadline checks the caller's deadline applies when it is earlier than the budget
+func TestOperationTimeoutCallerDeadline(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+	manager, err := tables.NewTableManager[Order](ctx,
+		tables.WithCluster(testClusterConfig),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(OrdersTableSpec),
+		tables.WithOperationTimeouts(10*time.Second, 10*time.Second, 10*time.Second, 10*time.Second))
+	require.NoError(t, err, "Should not error starting up")
+
+	// Arrange
+	callCtx, cancel := context.WithDeadline(ctx, time.Now().Add(-time.Second))
+	defer cancel()
+
+	// Act
+	_, errGet := manager.GetByPartitionKey(callCtx, "timeout-test-3")
+
+	// Assert
+	var timeoutErr *tables.TimeoutError
+	require.True(t, errors.As(errGet, &timeoutErr), "Should get a TimeoutError")
+	require.True(t, timeoutErr.CallerDeadline, "Should report the caller's deadline")
+	require.ErrorIs(t, errGet, context.DeadlineExceeded, "Should wrap the context error")
+}
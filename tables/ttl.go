@@ -0,0 +1,129 @@
+package tables
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/gocql/gocql"
+)
+
+// rowTTLBindingName is the name we bind a per-row TTL against. It just needs to be a unique
+// name that won't be part of the table specification.
+const rowTTLBindingName = "charybdis_ttl"
+
+var (
+	rowTTLTypeDuration = reflect.TypeFor[time.Duration]()
+	rowTTLTypeTime     = reflect.TypeFor[time.Time]()
+)
+
+// rowTTLField describes the field of a record type that provides a per-row TTL
+type rowTTLField struct {
+	index  []int // Field index path within the struct
+	isTime bool  // The field is an expiry time, rather than a duration
+}
+
+// findRowTTLField looks for a field tagged with TagNameTTL on the record type. If there is
+// no such field, nil is returned.
+func findRowTTLField[T any]() (*rowTTLField, error) {
+	t := reflect.TypeFor[T]()
+	if t.Kind() != reflect.Struct {
+		return nil, nil
+	}
+
+	var found *rowTTLField
+	for _, field := range reflect.VisibleFields(t) {
+		tagVal := field.Tag.Get(TagNameTTL)
+		if tagVal == "" {
+			continue
+		}
+
+		enabled, errParse := strconv.ParseBool(tagVal)
+		if errParse != nil {
+			return nil, fmt.Errorf("parsing %s tag on field %q: %w", TagNameTTL, field.Name, errParse)
+		}
+		if !enabled {
+			continue
+		}
+
+		if found != nil {
+			return nil, fmt.Errorf("field %q: %w", field.Name, ErrMultipleTTLFields)
+		}
+
+		ft := field.Type
+		if ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+
+		switch ft {
+		case rowTTLTypeDuration, rowTTLTypeTime:
+			found = &rowTTLField{
+				index:  field.Index,
+				isTime: ft == rowTTLTypeTime,
+			}
+		default:
+			return nil, fmt.Errorf("field %q has type %v: %w", field.Name, field.Type, ErrInvalidTTLField)
+		}
+	}
+
+	return found, nil
+}
+
+// bindingValue gets the TTL value to bind for a given record. Records without a TTL set fall
+// back to the supplied default, or are left unset so the table default applies.
+func (r *rowTTLField) bindingValue(instance any, fallback time.Duration) any {
+	ttl := r.extract(instance)
+	if ttl <= 0 {
+		ttl = fallback
+	}
+	if ttl <= 0 {
+		return gocql.UnsetValue
+	}
+
+	// Scylla works in whole seconds, and a TTL of zero means "never expire"
+	seconds := int64(ttl / time.Second)
+	if seconds < 1 {
+		seconds = 1
+	}
+
+	return seconds
+}
+
+// extract reads the TTL from the record, returning zero if not set
+func (r *rowTTLField) extract(instance any) time.Duration {
+	v := reflect.ValueOf(instance)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return 0
+		}
+		v = v.Elem()
+	}
+
+	f, err := v.FieldByIndexErr(r.index)
+	if err != nil {
+		return 0
+	}
+	if f.Kind() == reflect.Pointer {
+		if f.IsNil() {
+			return 0
+		}
+		f = f.Elem()
+	}
+
+	if r.isTime {
+		expiry := f.Interface().(time.Time)
+		if expiry.IsZero() {
+			return 0
+		}
+
+		// Already expired rows get the shortest TTL we can give them
+		remaining := time.Until(expiry)
+		if remaining < time.Second {
+			remaining = time.Second
+		}
+		return remaining
+	}
+
+	return f.Interface().(time.Duration)
+}
@@ -0,0 +1,314 @@
+package tables
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/scylladb/gocqlx/v3"
+	"github.com/scylladb/gocqlx/v3/qb"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// UnitOfWork collects writes to several tables, possibly owned by different managers, and commits them
+// together as one logged batch, so that either all of them are applied or none are. Every manager must
+// share a session, such as one given with WithSession or WithSessionPool, although their tables may be
+// in different keyspaces. Writes with preconditions can't be part of a unit of work.
+//
+// Pre-change hooks run for each write as the unit is committed, before the batch is sent, and post-change
+// hooks once it has been applied. A unit of work can be committed once.
+type UnitOfWork struct {
+	mu         sync.Mutex
+	operations []*unitOperation
+	committed  bool
+}
+
+// unitOperation is a single write collected by a unit of work
+type unitOperation struct {
+	manager    *unitManager                    // Manager making the write
+	beforeFn   func(ctx context.Context) error // Runs the lifecycle methods and pre-change hooks
+	bindFn     func(batch *gocqlx.Batch) error // Adds the statement to the batch
+	afterFn    func(ctx context.Context) error // Runs the post-change hooks
+	invalidate func()                          // Invalidates any cached reads of the row
+}
+
+// unitManager is the part of a manager needed to commit a unit of work, independent of its record type
+type unitManager struct {
+	name             string
+	session          *gocqlx.Session // Only connected once the manager has started
+	startup          *startupState
+	writeConsistency gocql.Consistency
+	budget           time.Duration
+	logger           *zap.Logger
+	tracer           trace.Tracer
+	doTracing        bool
+	traceAttributes  []attribute.KeyValue
+	faultyExec       func(ctx context.Context, operation string, exec func() error) func() error
+}
+
+// NewUnitOfWork creates an empty unit of work
+func NewUnitOfWork() *UnitOfWork {
+	return &UnitOfWork{}
+}
+
+// AddInsert adds an insert to a unit of work. Options such as WithInsertTTL and WithInsertUsingTimestamp
+// apply to this write alone.
+func AddInsert[T any](uow *UnitOfWork, manager TableManager[T], instance *T, opts ...InsertOption) error {
+	t, ok := manager.(*tableManagerImpl[T])
+	if !ok {
+		return fmt.Errorf("%w: %T", ErrUnsupportedManager, manager)
+	}
+
+	builder := qb.Insert(t.qualifiedTableName).Columns(t.allColumnNames...)
+	if t.rowTTL != nil {
+		builder = builder.TTLNamed(rowTTLBindingName)
+	}
+
+	skipNil := false
+	for _, opt := range opts {
+		if opt.isPrecondition() {
+			return ErrBatchPrecondition
+		}
+		builder = opt.applyToInsertBuilder(builder)
+		skipNil = skipNil || opt.skipsNilColumns()
+	}
+
+	return uow.add(t.unitWrite(instance, t.prepareInsert, func(batch *gocqlx.Batch) error {
+		query := t.Session.Query(builder.ToCql()).WithBindTransformer(t.unsetTransformer(skipNil))
+		defer query.Release()
+		return batch.BindStructMap(query, instance, t.rowTTLBindings(instance))
+	}))
+}
+
+// AddUpsert adds an upsert to a unit of work. Options such as WithTTL and WithUpsertUsingTimestamp apply to
+// this write alone.
+func AddUpsert[T any](uow *UnitOfWork, manager TableManager[T], instance *T, opts ...UpsertOption) error {
+	t, ok := manager.(*tableManagerImpl[T])
+	if !ok {
+		return fmt.Errorf("%w: %T", ErrUnsupportedManager, manager)
+	}
+
+	builder := qb.Update(t.qualifiedTableName).
+		Set(t.nonKeyColumns...).
+		Where(t.allKeyPredicates...)
+	if t.rowTTL != nil {
+		builder = builder.TTLNamed(rowTTLBindingName)
+	}
+
+	optionVals := map[string]any{}
+	skipNil := false
+	for _, opt := range opts {
+		if opt.isPrecondition() {
+			return ErrBatchPrecondition
+		}
+		builder = opt.applyToUpdateBuilder(builder)
+		maps.Copy(optionVals, opt.getMapData())
+		skipNil = skipNil || opt.skipsNilColumns()
+	}
+
+	return uow.add(t.unitWrite(instance, t.prepareUpdate, func(batch *gocqlx.Batch) error {
+		query := builder.Query(t.Session).WithBindTransformer(t.unsetTransformer(skipNil))
+		defer query.Release()
+
+		additionalVals := maps.Clone(optionVals)
+		maps.Copy(additionalVals, t.rowTTLBindings(instance))
+		return batch.BindStructMap(query, instance, additionalVals)
+	}))
+}
+
+// AddDelete adds the delete of a row to a unit of work. Only the keys of the instance need be set. Options
+// such as WithDeleteUsingTimestamp apply to this write alone. If the manager has pre-delete hooks, the row
+// is read as the unit is committed and passed to them.
+func AddDelete[T any](uow *UnitOfWork, manager TableManager[T], instance *T, opts ...DeleteOption) error {
+	t, ok := manager.(*tableManagerImpl[T])
+	if !ok {
+		return fmt.Errorf("%w: %T", ErrUnsupportedManager, manager)
+	}
+
+	builder := qb.Delete(t.qualifiedTableName).Where(t.allKeyPredicates...)
+	for _, opt := range opts {
+		if opt.isPrecondition() {
+			return ErrBatchPrecondition
+		}
+		builder = opt.applyToDeleteBuilder(builder)
+	}
+
+	op := &unitOperation{
+		manager: t.unitManager(),
+		beforeFn: func(ctx context.Context) error {
+			if len(t.preDeleteHooks) == 0 {
+				return nil
+			}
+
+			existing, err := t.GetByExample(ctx, instance)
+			if err != nil {
+				return fmt.Errorf("fetching existing record for pre-delete hooks: %w", err)
+			}
+			errHooks := t.runPreDeleteHooks(ctx, existing)
+			if errHooks != nil {
+				return fmt.Errorf("running pre-delete hooks: %w", errHooks)
+			}
+			return nil
+		},
+		afterFn:    func(ctx context.Context) error { return nil },
+		invalidate: func() { t.invalidateCached(instance) },
+		bindFn: func(batch *gocqlx.Batch) error {
+			query := t.Session.Query(builder.ToCql())
+			for _, opt := range opts {
+				query = opt.applyToQuery(query)
+			}
+			defer query.Release()
+
+			_, bindings := deleteBindings(opts)
+			keyValues := t.columnValues(instance, slices.Concat(t.TableMetadata.PartKey, t.TableMetadata.SortKey))
+			return batch.Bind(query, append(keyValues, bindings...)...)
+		},
+	}
+	return uow.add(op)
+}
+
+// Len gets the number of writes in the unit of work
+func (u *UnitOfWork) Len() int {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return len(u.operations)
+}
+
+// Commit applies every write in the unit of work as one logged batch. Pre-change hooks are run first, and
+// post-change hooks once the batch has been applied. Returns ErrSessionMismatch if the managers don't share
+// a session. Committing an empty unit of work does nothing.
+func (u *UnitOfWork) Commit(ctx context.Context) error {
+	u.mu.Lock()
+	if u.committed {
+		u.mu.Unlock()
+		return ErrUnitOfWorkCommitted
+	}
+	u.committed = true
+	operations := u.operations
+	u.mu.Unlock()
+
+	if len(operations) == 0 {
+		return nil
+	}
+
+	// The batch is sent using the settings of the first manager
+	first := operations[0].manager
+	return doWithTracing(ctx, first.tracer, "UnitOfWork/Commit", first.traceAttributes, first.doTracing, func(ctx context.Context) error {
+		// Every manager must have started before we can compare their sessions
+		for _, op := range operations {
+			if err := op.manager.startup.wait(ctx); err != nil {
+				return fmt.Errorf("%s: %w", op.manager.name, err)
+			}
+			if op.manager.session.Session != first.session.Session {
+				return fmt.Errorf("%w: %s and %s", ErrSessionMismatch, first.name, op.manager.name)
+			}
+		}
+
+		// Pre-change hooks
+		for _, op := range operations {
+			if err := op.beforeFn(ctx); err != nil {
+				return err
+			}
+		}
+
+		// A failed batch may still have been applied, so cached reads are invalidated whatever the outcome
+		defer func() {
+			for _, op := range operations {
+				op.invalidate()
+			}
+		}()
+
+		err := doWithBudget(ctx, first.startup, "UnitOfWork/Commit", first.budget, func(retryCtx context.Context) error {
+			st := time.Now()
+
+			batch := first.session.ContextBatch(retryCtx, gocql.LoggedBatch)
+			batch.SetConsistency(first.writeConsistency)
+			for _, op := range operations {
+				if errBind := op.bindFn(batch); errBind != nil {
+					return errBind
+				}
+			}
+
+			first.logger.Debug("unit of work", zap.Int("statements", batch.Size()))
+
+			var timeRemaining AsStringerFunc = func() string {
+				return time.Since(st).String()
+			}
+
+			logger := first.logger.
+				With(
+					zap.String("operation", "unit of work"),
+					zap.Duration("timeout", first.budget),
+				).
+				WithLazy(
+					zap.Stringer("execution_time_to_now", timeRemaining),
+				)
+
+			// A logged batch is applied in full once it reaches the batch log, so retrying is safe
+			_, errExec := retryBeforeTimeout(logger, executableFuncs{
+				exec: first.faultyExec(retryCtx, OperationBatch, func() error {
+					return first.session.ExecuteBatch(batch)
+				}),
+			}, false)
+			return errExec
+		})
+		if err != nil {
+			return err
+		}
+
+		// Post-change hooks
+		for _, op := range operations {
+			if errPost := op.afterFn(ctx); errPost != nil {
+				return errPost
+			}
+		}
+
+		return nil
+	})
+}
+
+// add adds a write to the unit of work
+func (u *UnitOfWork) add(op *unitOperation) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if u.committed {
+		return ErrUnitOfWorkCommitted
+	}
+	u.operations = append(u.operations, op)
+	return nil
+}
+
+// unitWrite creates a unit of work operation writing a record, preparing it before the commit and running
+// its post-change hooks after
+func (t *tableManagerImpl[T]) unitWrite(instance *T, prepare func(ctx context.Context, instance *T) error, bind func(batch *gocqlx.Batch) error) *unitOperation {
+	return &unitOperation{
+		manager:    t.unitManager(),
+		beforeFn:   func(ctx context.Context) error { return prepare(ctx, instance) },
+		bindFn:     bind,
+		afterFn:    func(ctx context.Context) error { return t.runPostHooks(ctx, instance) },
+		invalidate: func() { t.invalidateCached(instance) },
+	}
+}
+
+// unitManager gets the settings needed to commit a unit of work including this manager
+func (t *tableManagerImpl[T]) unitManager() *unitManager {
+	return &unitManager{
+		name:             t.Name,
+		session:          &t.Session,
+		startup:          t.startup,
+		writeConsistency: t.writeConsistency,
+		budget:           t.timeouts.write,
+		logger:           t.Logger,
+		tracer:           t.Tracer,
+		doTracing:        t.DoTracing,
+		traceAttributes:  t.TraceAttributes,
+		faultyExec:       t.faultyExec,
+	}
+}
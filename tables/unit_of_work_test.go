@@ -0,0 +1,106 @@
+package tables_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/scylladb/gocqlx/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeroflucs-given/charybdis/tables"
+)
+
+// TestUnitOfWork checks writes to several tables are committed together, with hooks around the commit
+func TestUnitOfWork(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+	cluster := testClusterConfig()
+	cluster.Keyspace = TestKeyspace
+	session, errSession := gocqlx.WrapSession(cluster.CreateSession())
+	require.NoError(t, errSession, "Should not error creating session")
+	defer session.Close()
+
+	orders, err := tables.NewTableManager[Order](ctx,
+		tables.WithSession(session),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(OrdersTableSpec))
+	require.NoError(t, err, "Should not error starting up orders")
+	items, err := tables.NewTableManager[OrderItem](ctx,
+		tables.WithSession(session),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(OrderItemsTableSpec))
+	require.NoError(t, err, "Should not error starting up items")
+
+	// Arrange
+	errInsert := items.Insert(ctx, &OrderItem{OrderID: "uow-order-1", ItemID: "removed", Quantity: 1})
+	require.NoError(t, errInsert, "Should not error inserting")
+
+	var events []string
+	items.AddPreChangeHook(func(ctx context.Context, record *OrderItem) error {
+		events = append(events, "pre:"+record.ItemID)
+		return nil
+	})
+	items.AddPostChangeHook(func(ctx context.Context, record *OrderItem) error {
+		events = append(events, "post:"+record.ItemID)
+		return nil
+	})
+	items.AddPreDeleteHook(func(ctx context.Context, record *OrderItem) error {
+		events = append(events, "delete:"+record.ItemID)
+		return nil
+	})
+
+	uow := tables.NewUnitOfWork()
+	require.NoError(t, tables.AddInsert(uow, orders, &Order{OrderID: "uow-order-1"}, tables.WithInsertTTL(time.Hour)), "Should add the insert")
+	require.NoError(t, tables.AddUpsert(uow, items, &OrderItem{OrderID: "uow-order-1", ItemID: "item-1", Quantity: 2}), "Should add the upsert")
+	require.NoError(t, tables.AddDelete(uow, items, &OrderItem{OrderID: "uow-order-1", ItemID: "removed"}), "Should add the delete")
+
+	// Act
+	errCommit := uow.Commit(ctx)
+	errAgain := uow.Commit(ctx)
+
+	// Assert
+	require.NoError(t, errCommit, "Should not error committing")
+	require.ErrorIs(t, errAgain, tables.ErrUnitOfWorkCommitted, "Should only commit once")
+	require.Equal(t, 3, uow.Len(), "Should hold every write")
+	require.Equal(t, []string{"pre:item-1", "delete:removed", "post:item-1"}, events, "Should run hooks around the commit")
+
+	order, errOrder := orders.GetByPrimaryKey(ctx, "uow-order-1")
+	require.NoError(t, errOrder, "Should not error getting the inserted order")
+	require.NotNil(t, order, "Should find the inserted order")
+	item, errItem := items.GetByPrimaryKey(ctx, "uow-order-1", "item-1")
+	require.NoError(t, errItem, "Should find the upserted item")
+	require.Equal(t, 2, item.Quantity, "Should write the upserted values")
+	removed, errRemoved := items.GetByPrimaryKey(ctx, "uow-order-1", "removed")
+	require.NoError(t, errRemoved, "Should not error getting the removed item")
+	require.Nil(t, removed, "Should delete the removed item")
+}
+
+// TestUnitOfWorkRejections checks writes that can't be batched together are rejected
+func TestUnitOfWorkRejections(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+	orders, err := tables.NewTableManager[Order](ctx,
+		tables.WithCluster(testClusterConfig),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(OrdersTableSpec))
+	require.NoError(t, err, "Should not error starting up orders")
+	items, err := tables.NewTableManager[OrderItem](ctx,
+		tables.WithCluster(testClusterConfig),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(OrderItemsTableSpec))
+	require.NoError(t, err, "Should not error starting up items")
+
+	// Arrange
+	uow := tables.NewUnitOfWork()
+	require.NoError(t, tables.AddInsert(uow, orders, &Order{OrderID: "uow-order-2"}), "Should add the insert")
+	require.NoError(t, tables.AddUpsert(uow, items, &OrderItem{OrderID: "uow-order-2", ItemID: "item-1"}), "Should add the upsert")
+
+	// Act
+	errPrecondition := tables.AddInsert(uow, orders, &Order{OrderID: "uow-order-3"}, tables.WithNotExists())
+	errCommit := uow.Commit(ctx)
+
+	// Assert
+	require.ErrorIs(t, errPrecondition, tables.ErrBatchPrecondition, "Should reject preconditions")
+	require.ErrorIs(t, errCommit, tables.ErrSessionMismatch, "Should reject managers with different sessions")
+}
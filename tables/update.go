@@ -2,13 +2,11 @@ package tables
 
 import (
 	"context"
-	"errors"
 	"maps"
-	"time"
+	"slices"
 
 	"github.com/gocql/gocql"
 	"github.com/scylladb/gocqlx/v3/qb"
-	"go.uber.org/zap"
 )
 
 // Update updates an object. It will error if the object does not exist.
@@ -20,24 +18,33 @@ func (t *tableManagerImpl[T]) Update(ctx context.Context, instance *T, opts ...U
 
 // updateInternal is a helper function that performs a single update
 func (t *tableManagerImpl[T]) updateInternal(ctx context.Context, instance *T, opts ...UpdateOption) error {
-	// Pre-change hooks
-	err := t.runPreHooks(ctx, instance)
+	// Lifecycle methods and pre-change hooks
+	err := t.prepareUpdate(ctx, instance)
 	if err != nil {
 		return err
 	}
 
+	// A failed write may still have been applied, so cached reads are invalidated whatever the outcome
+	defer t.invalidateCached(instance)
+
 	// Build our query
 	query := qb.Update(t.qualifiedTableName).
 		Set(t.nonKeyColumns...).
 		Where(t.allKeyPredicates...)
+	if t.rowTTL != nil {
+		query = query.TTLNamed(rowTTLBindingName)
+	}
 
 	additionalVals := map[string]any{}
+	maps.Copy(additionalVals, t.rowTTLBindings(instance))
 	havePreconditions := false
+	skipNil := false
 
 	for _, opt := range opts {
 		if opt.isPrecondition() {
 			havePreconditions = true
 		}
+		skipNil = skipNil || opt.skipsNilColumns()
 		query = opt.applyToUpdateBuilder(query)
 		maps.Copy(additionalVals, opt.getMapData())
 	}
@@ -48,47 +55,37 @@ func (t *tableManagerImpl[T]) updateInternal(ctx context.Context, instance *T, o
 			Existing()
 	}
 
-	st := time.Now()
-	retryCtx, cancel := context.WithTimeout(ctx, t.queryTimeout)
-	defer cancel()
-
-	var applied bool
-	stmt, params := query.ToCql()
-	for {
-		applied, err = t.Session.
-			ContextQuery(retryCtx, stmt, params).
-			BindStructMap(instance, additionalVals).
-			ExecCASRelease()
+	budget := budgetFor(t.timeouts.lwt, opts)
+	return doWithBudget(ctx, t.startup, t.Name+"/Update", budget, func(retryCtx context.Context) error {
 
-		if err == nil {
-			break
+		keyColumns := slices.Concat(t.TableMetadata.PartKey, t.TableMetadata.SortKey)
+		expect := lwtExpectation{
+			keys:    t.columnValues(instance, keyColumns),
+			written: t.writtenValues(instance, t.nonKeyColumns, skipNil),
 		}
 
-		var wto *gocql.RequestErrWriteTimeout
-		retryable := errors.As(err, &wto)
-		if !retryable {
+		stmt, params := query.ToCql()
+		applied, err := t.lwtResolver(gocql.Any).exec(ctx, "update", t.faultyExecCAS(retryCtx, OperationUpdate, func() (bool, error) {
+			return t.Session.
+				ContextQuery(retryCtx, stmt, params).
+				WithBindTransformer(t.unsetTransformer(skipNil)).
+				BindStructMap(instance, additionalVals).
+				ExecCASRelease()
+		}), expect)
+		if err != nil {
 			return err
 		}
 
-		t.Logger.Debug("update retrying from early write timeout",
-			zap.String("consistency", wto.Consistency.String()),
-			zap.Int("received", wto.Received),
-			zap.Int("blockFor", wto.BlockFor),
-			zap.String("writeType", wto.WriteType),
-			zap.Duration("set_timeout", t.queryTimeout),
-			zap.Duration("execution_time_to_now", time.Since(st)),
-		)
-	}
-
-	if !applied {
-		return ErrPreconditionFailed
-	}
+		if !applied {
+			return ErrPreconditionFailed
+		}
 
-	// Post-change hooks
-	errPost := t.runPostHooks(ctx, instance)
-	if errPost != nil {
-		return errPost
-	}
+		// Post-change hooks
+		errPost := t.runPostHooks(ctx, instance)
+		if errPost != nil {
+			return errPost
+		}
 
-	return nil
+		return nil
+	})
 }
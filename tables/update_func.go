@@ -0,0 +1,231 @@
+package tables
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/scylladb/gocqlx/v3/qb"
+	"go.uber.org/zap"
+)
+
+// expectedValueBindingPrefix prefixes the names we bind expected values of LWT conditions against.
+// It just needs to avoid clashing with column names in the table specification.
+const expectedValueBindingPrefix = "charybdis_expect_"
+
+// UpdateFunc performs a read-modify-write of a single record by primary key. The current record is read
+// at serial consistency and passed to fn, or nil if it does not exist. The result of fn is then written
+// using an LWT conditioned on the columns that changed (or the version column, if set), retrying with
+// backoff if the record changed in the meantime. Missing records are inserted with IF NOT EXISTS. If fn
+// returns nil, nothing is written.
+func (t *tableManagerImpl[T]) UpdateFunc(ctx context.Context, keys []any, fn func(current *T) (*T, error), opts ...UpdateFuncOption) error {
+	return doWithTracing(ctx, t.Tracer, t.Name+"/UpdateFunc", t.TraceAttributes, t.DoTracing, func(ctx context.Context) error {
+		if len(keys) != len(t.allKeyPredicates) {
+			return fmt.Errorf("%w: expected %d, got %d", ErrKeyCount, len(t.allKeyPredicates), len(keys))
+		}
+
+		params := collectUpdateFuncParameters(opts)
+		backoff := params.initialBackoff
+
+		for attempt := 1; ; attempt++ {
+			err := t.updateFuncAttempt(ctx, keys, fn, params)
+			if !errors.Is(err, ErrPreconditionFailed) {
+				return err
+			}
+			if attempt >= params.maxAttempts {
+				return fmt.Errorf("giving up after %d attempts: %w", attempt, err)
+			}
+
+			// Jitter our delay, so that competing writers don't retry in lock-step
+			delay := backoff/2 + rand.N(backoff/2+1)
+			t.Logger.Debug("update func retrying after conflict",
+				zap.Int("attempt", attempt),
+				zap.Duration("delay", delay),
+			)
+
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(delay):
+			}
+
+			backoff = min(backoff*2, params.maxBackoff)
+		}
+	})
+}
+
+// updateFuncAttempt performs a single read-modify-write cycle
+func (t *tableManagerImpl[T]) updateFuncAttempt(ctx context.Context, keys []any, fn func(current *T) (*T, error), params *updateFuncParameters) error {
+	current, err := t.getSerial(ctx, params.serialConsistency, keys...)
+	if err != nil {
+		return fmt.Errorf("reading current record: %w", err)
+	}
+
+	// The function gets its own copy, so that in-place changes can still be detected
+	updated, err := fn(cloneRecord(current))
+	if err != nil {
+		return err
+	}
+	if updated == nil {
+		return nil
+	}
+
+	if current == nil {
+		insertOpts, err := updateInsertOptions(params.writeOpts)
+		if err != nil {
+			return err
+		}
+		return t.insertInternal(ctx, updated, true, params.serialConsistency, insertOpts...)
+	}
+
+	// Lifecycle methods and pre-change hooks run first, so that any columns they set are written too
+	err = t.prepareUpdate(ctx, updated)
+	if err != nil {
+		return err
+	}
+
+	keyColumns := slices.Concat(t.TableMetadata.PartKey, t.TableMetadata.SortKey)
+	if !reflect.DeepEqual(t.columnValues(current, keyColumns), t.columnValues(updated, keyColumns)) {
+		return ErrKeyChanged
+	}
+
+	// Work out what has changed, and what we expect the record to look like
+	before := t.columnValues(current, t.nonKeyColumns)
+	after := t.columnValues(updated, t.nonKeyColumns)
+
+	var changed []string
+	var conditions []qb.Cmp
+	additionalVals := map[string]any{}
+
+	for i, col := range t.nonKeyColumns {
+		if reflect.DeepEqual(before[i], after[i]) {
+			continue
+		}
+		changed = append(changed, col)
+		if params.versionColumn == "" {
+			name := expectedValueBindingPrefix + col
+			conditions = append(conditions, qb.EqNamed(col, name))
+			additionalVals[name] = before[i]
+		}
+	}
+
+	if len(changed) == 0 {
+		return nil
+	}
+
+	if params.versionColumn != "" {
+		name := expectedValueBindingPrefix + params.versionColumn
+		conditions = append(conditions, qb.EqNamed(params.versionColumn, name))
+		additionalVals[name] = t.columnValues(current, []string{params.versionColumn})[0]
+	}
+
+	return t.compareAndSet(ctx, updated, changed, conditions, additionalVals, params.serialConsistency, params.writeOpts...)
+}
+
+// updateInsertOptions converts the write options of a read-modify-write cycle for use when the record is
+// missing and must be inserted instead. Preconditions can't hold for a missing record, so they fail the
+// write with ErrPreconditionFailed.
+func updateInsertOptions(opts []UpdateOption) ([]InsertOption, error) {
+	result := make([]InsertOption, 0, len(opts))
+	for _, opt := range opts {
+		if opt.isPrecondition() {
+			return nil, fmt.Errorf("%w: record does not exist", ErrPreconditionFailed)
+		}
+
+		insertOpt, ok := opt.(InsertOption)
+		if !ok {
+			return nil, fmt.Errorf("%w: %T can't be applied to an insert", ErrUnsupportedOption, opt)
+		}
+		result = append(result, insertOpt)
+	}
+	return result, nil
+}
+
+// getSerial gets a record by primary key at the given serial consistency, returning nil if not found
+func (t *tableManagerImpl[T]) getSerial(ctx context.Context, consistency gocql.Consistency, primaryKeys ...any) (*T, error) {
+	return returnWithBudget(ctx, t.startup, t.Name+"/GetSerial", t.timeouts.read, func(ctx context.Context) (*T, error) {
+		stmt, params := t.basicQueryBuilder().Where(t.allKeyPredicates...).ToCql()
+		target, err := t.getRecord(ctx, t.Session.Query(stmt, params).WithContext(ctx).Consistency(consistency).Bind(primaryKeys...))
+		if errors.Is(err, gocql.ErrNotFound) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return target, nil
+	})
+}
+
+// compareAndSet writes the given columns of a record, provided the conditions hold. Returns
+// ErrPreconditionFailed if they don't. Nil columns tagged omitempty, or all nil columns with
+// WithSkipNilColumns, are left unset. Non-serial consistency levels leave the session's serial
+// consistency in place. The record should already have been through prepareUpdate.
+func (t *tableManagerImpl[T]) compareAndSet(ctx context.Context, instance *T, columns []string, conditions []qb.Cmp, conditionVals map[string]any, serialConsistency gocql.Consistency, opts ...UpdateOption) error {
+	// A failed write may still have been applied, so cached reads are invalidated whatever the outcome
+	defer t.invalidateCached(instance)
+
+	builder := qb.Update(t.qualifiedTableName).
+		Set(columns...).
+		Where(t.allKeyPredicates...).
+		If(conditions...)
+	if t.rowTTL != nil {
+		builder = builder.TTLNamed(rowTTLBindingName)
+	}
+
+	additionalVals := maps.Clone(conditionVals)
+	maps.Copy(additionalVals, t.rowTTLBindings(instance))
+
+	skipNil := false
+	for _, opt := range opts {
+		builder = opt.applyToUpdateBuilder(builder)
+		maps.Copy(additionalVals, opt.getMapData())
+		skipNil = skipNil || opt.skipsNilColumns()
+	}
+
+	budget := budgetFor(t.timeouts.lwt, opts)
+	return doWithBudget(ctx, t.startup, t.Name+"/CompareAndSet", budget, func(retryCtx context.Context) error {
+
+		// Our conditions are equality checks on expected values, which tell us if our write can't have happened
+		expect := lwtExpectation{
+			keys:     t.columnValues(instance, slices.Concat(t.TableMetadata.PartKey, t.TableMetadata.SortKey)),
+			written:  t.writtenValues(instance, columns, skipNil),
+			expected: map[string]any{},
+		}
+		for name, val := range conditionVals {
+			if col, ok := strings.CutPrefix(name, expectedValueBindingPrefix); ok {
+				expect.expected[col] = val
+			}
+		}
+
+		stmt, params := builder.ToCql()
+		applied, err := t.lwtResolver(serialConsistency).exec(ctx, "compare and set", t.faultyExecCAS(retryCtx, OperationUpdate, func() (bool, error) {
+			query := t.Session.
+				ContextQuery(retryCtx, stmt, params).
+				Consistency(t.writeConsistency).
+				WithBindTransformer(t.unsetTransformer(skipNil)).
+				BindStructMap(instance, additionalVals)
+			if serialConsistency.IsSerial() {
+				query.SerialConsistency(serialConsistency)
+			}
+			return query.ExecCASRelease()
+		}), expect)
+		if err != nil {
+			return err
+		}
+
+		if !applied {
+			return ErrPreconditionFailed
+		}
+
+		// Post-change hooks
+		return t.runPostHooks(ctx, instance)
+	})
+}
@@ -5,7 +5,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gocql/gocql"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
 
 	"github.com/zeroflucs-given/charybdis/tables"
 )
@@ -187,3 +189,224 @@ func TestWithSimpleIfNotExists(t *testing.T) {
 	// Assert
 	require.Error(t, errUpdate, "Expect error updating")
 }
+
+// TestUpdateFuncConcurrentIncrements checks read-modify-write cycles don't lose updates under contention
+func TestUpdateFuncConcurrentIncrements(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+	manager, err := tables.NewTableManager[OrderItem](ctx,
+		tables.WithCluster(testClusterConfig),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(OrderItemsTableSpec))
+	require.NoError(t, err, "Should not error starting up")
+
+	increment := func(current *OrderItem) (*OrderItem, error) {
+		if current == nil {
+			return &OrderItem{OrderID: "update-func-1", ItemID: "item-1", Quantity: 1}, nil
+		}
+		current.Quantity++
+		return current, nil
+	}
+
+	// Act
+	const writers = 5
+	grp, grpCtx := errgroup.WithContext(ctx)
+	for range writers {
+		grp.Go(func() error {
+			return manager.UpdateFunc(grpCtx, []any{"update-func-1", "item-1"}, increment,
+				tables.WithUpdateFuncAttempts(50))
+		})
+	}
+
+	// Assert
+	require.NoError(t, grp.Wait(), "Should not error updating")
+	fetched, errGet := manager.GetByPrimaryKey(ctx, "update-func-1", "item-1")
+	require.NoError(t, errGet, "Should not error fetching")
+	require.NotNil(t, fetched, "Should get object back")
+	require.Equal(t, writers, fetched.Quantity, "Should not lose any increments")
+}
+
+// TestUpdateFuncNoChange checks a function returning nil makes no write
+func TestUpdateFuncNoChange(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+	manager, err := tables.NewTableManager[OrderItem](ctx,
+		tables.WithCluster(testClusterConfig),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(OrderItemsTableSpec))
+	require.NoError(t, err, "Should not error starting up")
+
+	// Act
+	errUpdate := manager.UpdateFunc(ctx, []any{"update-func-2", "item-1"}, func(current *OrderItem) (*OrderItem, error) {
+		return nil, nil
+	})
+
+	// Assert
+	require.NoError(t, errUpdate, "Should not error")
+	fetched, errGet := manager.GetByPrimaryKey(ctx, "update-func-2", "item-1")
+	require.NoError(t, errGet, "Should not error fetching")
+	require.Nil(t, fetched, "Should not have created a record")
+}
+
+// TestUpdateFuncInsertWithOptions checks write options apply when a missing record is inserted
+func TestUpdateFuncInsertWithOptions(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+	manager, err := tables.NewTableManager[OrderItem](ctx,
+		tables.WithCluster(testClusterConfig),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(OrderItemsTableSpec))
+	require.NoError(t, err, "Should not error starting up")
+
+	// Act
+	errUpdate := manager.UpdateFunc(ctx, []any{"update-func-3", "item-1"}, func(current *OrderItem) (*OrderItem, error) {
+		return &OrderItem{OrderID: "update-func-3", ItemID: "item-1", Quantity: 1}, nil
+	}, tables.WithUpdateFuncSerialConsistency(gocql.LocalSerial),
+		tables.WithUpdateFuncWriteOptions(tables.WithUpdateTTL(time.Second)))
+	inserted, errInserted := manager.GetByPrimaryKey(ctx, "update-func-3", "item-1")
+	time.Sleep(time.Second * 2)
+
+	// Assert
+	require.NoError(t, errUpdate, "Should not error inserting")
+	require.NoError(t, errInserted, "Should not error fetching")
+	require.NotNil(t, inserted, "Should have inserted the record")
+	expired, errExpired := manager.GetByPrimaryKey(ctx, "update-func-3", "item-1")
+	require.NoError(t, errExpired, "Should not error fetching")
+	require.Nil(t, expired, "Should have inserted the record with the TTL")
+}
+
+// StampedSelection is a market selection that stamps its market name whenever it is updated
+type StampedSelection struct {
+	MarketID    string  `cql:"market_id"`
+	SelectionID string  `cql:"selection_id"`
+	MarketName  string  `cql:"market_name"`
+	Price       float64 `cql:"price"`
+}
+
+// BeforeUpdate stamps the market name
+func (s *StampedSelection) BeforeUpdate(ctx context.Context) error {
+	s.MarketName = "Stamped Name"
+	return nil
+}
+
+// TestUpdateFuncWritesStampedColumns checks columns set by BeforeUpdate are written along with the function's changes
+func TestUpdateFuncWritesStampedColumns(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+	manager, err := tables.NewTableManager[StampedSelection](ctx,
+		tables.WithCluster(testClusterConfig),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(MarketSelectionsTableSpec))
+	require.NoError(t, err, "Should not error starting up")
+
+	// Arrange
+	errInsert := manager.Insert(ctx, &StampedSelection{
+		MarketID:    "update-func-stamp-1",
+		SelectionID: "home",
+		MarketName:  "Original Name",
+		Price:       1.5,
+	})
+	require.NoError(t, errInsert, "Should not error inserting")
+
+	// Act
+	errUpdate := manager.UpdateFunc(ctx, []any{"update-func-stamp-1", "home"}, func(current *StampedSelection) (*StampedSelection, error) {
+		current.Price = 2.5
+		return current, nil
+	})
+
+	// Assert
+	require.NoError(t, errUpdate, "Should not error updating")
+	fetched, errGet := manager.GetByPrimaryKey(ctx, "update-func-stamp-1", "home")
+	require.NoError(t, errGet, "Should not error fetching")
+	require.NotNil(t, fetched, "Should get object back")
+	require.Equal(t, 2.5, fetched.Price, "Should write the function's change")
+	require.Equal(t, "Stamped Name", fetched.MarketName, "Should write the column stamped by BeforeUpdate")
+}
+
+// TestCompareAndSwap checks swaps only apply when the expected values match
+func TestCompareAndSwap(t *testing.T) {
+	// Test globals
+	ctx := context.Background()
+	manager, err := tables.NewTableManager[Order](ctx,
+		tables.WithCluster(testClusterConfig),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(OrdersTableSpec))
+	require.NoError(t, err, "Should not error starting up")
+
+	// Arrange
+	orig := &Order{
+		OrderID:         "update-test-cas",
+		ShippingAddress: testAddress(5, "Initial Street", "Somerville"),
+	}
+	errInsert := manager.Insert(ctx, orig)
+	require.NoError(t, errInsert, "Should not error inserting")
+
+	stale := &Order{
+		OrderID:         "update-test-cas",
+		ShippingAddress: testAddress(5, "Stale Street", "Somerville"),
+	}
+	replacement := &Order{
+		OrderID:         "update-test-cas",
+		ShippingAddress: testAddress(5, "Swapped Street", "Somerville"),
+	}
+
+	// Act
+	errStale := manager.CompareAndSwap(ctx, stale, replacement, "shipping_address")
+	errSwap := manager.CompareAndSwap(ctx, orig, replacement, "shipping_address")
+	errColumn := manager.CompareAndSwap(ctx, orig, replacement, "order_id")
+
+	// Assert
+	require.ErrorIs(t, errStale, tables.ErrPreconditionFailed, "Stale expectation should fail")
+	require.NoError(t, errSwap, "Matching expectation should swap")
+	require.ErrorIs(t, errColumn, tables.ErrUnknownColumn, "Key columns cannot be compared")
+	fetched, errGet := manager.GetByPartitionKey(ctx, "update-test-cas")
+	require.NoError(t, errGet, "Should not error fetching")
+	require.NotNil(t, fetched, "Should get object back")
+	require.Equal(t, testAddress(5, "Swapped Street", "Somerville"), fetched.ShippingAddress, "Swap should have persisted")
+}
+
+// TestCompareAndSwapLeavesOmittedColumns checks nil columns tagged omitempty are left untouched by a swap
+func TestCompareAndSwapLeavesOmittedColumns(t *testing.T) {
+	type SparseSelection struct {
+		MarketID    string  `cql:"market_id"`
+		SelectionID string  `cql:"selection_id"`
+		MarketName  *string `cql:"market_name,omitempty"`
+		Price       float64 `cql:"price"`
+	}
+
+	// Test globals
+	ctx := context.Background()
+	manager, err := tables.NewTableManager[MarketSelection](ctx,
+		tables.WithCluster(testClusterConfig),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(MarketSelectionsTableSpec))
+	require.NoError(t, err, "Should not error starting up")
+	sparseManager, err := tables.NewTableManager[SparseSelection](ctx,
+		tables.WithCluster(testClusterConfig),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(MarketSelectionsTableSpec))
+	require.NoError(t, err, "Should not error starting up")
+
+	// Arrange
+	errInsert := manager.Insert(ctx, &MarketSelection{
+		MarketID:    "cas-sparse-1",
+		SelectionID: "home",
+		MarketName:  "Kept Name",
+		Price:       1.5,
+	})
+	require.NoError(t, errInsert, "Should not error inserting")
+
+	// Act
+	errSwap := sparseManager.CompareAndSwap(ctx,
+		&SparseSelection{MarketID: "cas-sparse-1", SelectionID: "home", Price: 1.5},
+		&SparseSelection{MarketID: "cas-sparse-1", SelectionID: "home", Price: 2.5},
+		"price")
+
+	// Assert
+	require.NoError(t, errSwap, "Matching expectation should swap")
+	fetched, errGet := manager.GetByPrimaryKey(ctx, "cas-sparse-1", "home")
+	require.NoError(t, errGet, "Should not error fetching")
+	require.NotNil(t, fetched, "Should get object back")
+	require.Equal(t, 2.5, fetched.Price, "Swap should have persisted")
+	require.Equal(t, "Kept Name", fetched.MarketName, "Omitted column should have been left untouched")
+}
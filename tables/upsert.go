@@ -4,12 +4,12 @@ import (
 	"context"
 	"errors"
 	"maps"
+	"slices"
 	"time"
 
 	"github.com/gocql/gocql"
 	"github.com/scylladb/gocqlx/v3/qb"
 	"go.uber.org/zap"
-	"golang.org/x/sync/errgroup"
 )
 
 // Upsert overwrites or inserts an object.
@@ -20,85 +20,197 @@ func (t *tableManagerImpl[T]) Upsert(ctx context.Context, instance *T, opts ...U
 }
 
 // UpsertBulk upserts many objects in parallel, up to a given number. If the concurrency limit is not set,
-// then a default of DefaultBulkConcurrency is used.
+// then a default of DefaultBulkConcurrency is used. If the concurrency is Adaptive, the manager's
+// AdaptiveLimiter decides how many run at once.
 func (t *tableManagerImpl[T]) UpsertBulk(ctx context.Context, instances []*T, concurrency int, opts ...UpsertOption) error {
-	if concurrency <= 0 {
-		concurrency = DefaultBulkConcurrency
-	}
-
 	return doWithTracing(ctx, t.Tracer, t.Name+"/UpsertBulk", t.TraceAttributes, t.DoTracing, func(ctx context.Context) error {
-		grp, grpCtx := errgroup.WithContext(ctx)
-		grp.SetLimit(concurrency)
-
-		for _, v := range instances {
-			item := v
-			grp.Go(func() error {
-				return t.upsertInternal(grpCtx, item, opts...)
-			})
-		}
-
-		return grp.Wait()
+		return runBulk(ctx, t.limiter, instances, concurrency, func(ctx context.Context, instance *T) error {
+			return t.upsertInternal(ctx, instance, opts...)
+		})
 	})
 }
 
 // upsertInternal is a helper function that performs a single upsert
 func (t *tableManagerImpl[T]) upsertInternal(ctx context.Context, instance *T, opts ...UpsertOption) error {
-	// Pre-change hooks
-	errPre := t.runPreHooks(ctx, instance)
+	// Lifecycle methods and pre-change hooks
+	errPre := t.prepareUpdate(ctx, instance)
 	if errPre != nil {
 		return errPre
 	}
 
+	// A failed write may still have been applied, so cached reads are invalidated whatever the outcome
+	defer t.invalidateCached(instance)
+
 	// Build our builder
 	builder := qb.Update(t.qualifiedTableName).
 		Set(t.nonKeyColumns...).
 		Where(t.allKeyPredicates...)
+	if t.rowTTL != nil {
+		builder = builder.TTLNamed(rowTTLBindingName)
+	}
 
 	additionalVals := map[string]any{}
+	maps.Copy(additionalVals, t.rowTTLBindings(instance))
+
+	skipNil := false
+	isLWT := false
 
 	for _, opt := range opts {
+		isLWT = isLWT || opt.isPrecondition()
 		builder = opt.applyToUpdateBuilder(builder)
 		maps.Copy(additionalVals, opt.getMapData())
+		skipNil = skipNil || opt.skipsNilColumns()
 	}
 
-	st := time.Now()
-	retryCtx, cancel := context.WithTimeout(ctx, t.queryTimeout)
-	defer cancel()
+	budget := budgetFor(t.timeouts.forWrite(isLWT), opts)
+	return doWithBudget(ctx, t.startup, t.Name+"/Upsert", budget, func(retryCtx context.Context) error {
+		st := time.Now()
+
+		query := builder.QueryContext(retryCtx, t.Session).
+			WithBindTransformer(t.unsetTransformer(skipNil)).
+			BindStructMap(instance, additionalVals)
+
+		defer query.Release()
+		queryString := query.String()
+		t.Logger.Debug("upsert by primary key", zap.String("query", queryString))
+
+		if isLWT {
+			// Upserts don't report whether their conditions held, but a timed out write may still have applied
+			keyColumns := slices.Concat(t.TableMetadata.PartKey, t.TableMetadata.SortKey)
+			_, err := t.lwtResolver(gocql.Any).exec(ctx, "upsert", t.faultyExecCAS(retryCtx, OperationUpsert, func() (bool, error) {
+				return true, query.Exec()
+			}), lwtExpectation{
+				keys:    t.columnValues(instance, keyColumns),
+				written: t.writtenValues(instance, t.nonKeyColumns, skipNil),
+			})
+			if err != nil {
+				return err
+			}
+		} else {
+			exec := t.faultyExec(retryCtx, OperationUpsert, query.Exec)
+			for {
+				err := exec()
+				if err == nil {
+					break
+				}
+
+				var wto *gocql.RequestErrWriteTimeout
+				retryable := errors.As(err, &wto)
+				if !retryable {
+					return err
+				}
+				reportAttempt(retryCtx, err)
+
+				t.Logger.Debug("upsert retrying from early write timeout",
+					zap.String("consistency", wto.Consistency.String()),
+					zap.Int("received", wto.Received),
+					zap.Int("blockFor", wto.BlockFor),
+					zap.String("writeType", wto.WriteType),
+					zap.Duration("set_timeout", budget),
+					zap.Duration("execution_time_to_now", time.Since(st)),
+				)
+			}
+		}
 
-	query := builder.QueryContext(retryCtx, t.Session).
-		BindStructMap(instance, additionalVals)
+		// Post-change hooks
+		errPost := t.runPostHooks(ctx, instance)
+		if errPost != nil {
+			return errPost
+		}
 
-	defer query.Release()
-	queryString := query.String()
-	t.Logger.Debug("upsert by primary key", zap.String("query", queryString))
+		return nil
+	})
+}
 
-	for {
-		err := query.Exec()
-		if err == nil {
-			break
+// upsertBatch upserts several objects in a single unlogged batch. The objects should share a partition,
+// so that the batch is applied by a single replica set. Upserts with preconditions are not supported.
+func (t *tableManagerImpl[T]) upsertBatch(ctx context.Context, instances []*T, opts ...UpsertOption) error {
+	// Lifecycle methods and pre-change hooks
+	for _, instance := range instances {
+		errPre := t.prepareUpdate(ctx, instance)
+		if errPre != nil {
+			return errPre
 		}
-
-		var wto *gocql.RequestErrWriteTimeout
-		retryable := errors.As(err, &wto)
-		if !retryable {
-			return err
+	}
+	defer func() {
+		for _, instance := range instances {
+			t.invalidateCached(instance)
 		}
+	}()
 
-		t.Logger.Debug("upsert retrying from early write timeout",
-			zap.String("consistency", wto.Consistency.String()),
-			zap.Int("received", wto.Received),
-			zap.Int("blockFor", wto.BlockFor),
-			zap.String("writeType", wto.WriteType),
-			zap.Duration("set_timeout", t.queryTimeout),
-			zap.Duration("execution_time_to_now", time.Since(st)),
-		)
+	// Build our builder
+	builder := qb.Update(t.qualifiedTableName).
+		Set(t.nonKeyColumns...).
+		Where(t.allKeyPredicates...)
+	if t.rowTTL != nil {
+		builder = builder.TTLNamed(rowTTLBindingName)
 	}
 
-	// Post-change hooks
-	errPost := t.runPostHooks(ctx, instance)
-	if errPost != nil {
-		return errPost
+	optionVals := map[string]any{}
+	skipNil := false
+
+	for _, opt := range opts {
+		if opt.isPrecondition() {
+			return ErrBatchPrecondition
+		}
+		builder = opt.applyToUpdateBuilder(builder)
+		maps.Copy(optionVals, opt.getMapData())
+		skipNil = skipNil || opt.skipsNilColumns()
 	}
 
-	return nil
+	budget := budgetFor(t.timeouts.write, opts)
+	return doWithBudget(ctx, t.startup, t.Name+"/UpsertBatch", budget, func(retryCtx context.Context) error {
+		st := time.Now()
+
+		query := builder.Query(t.Session).
+			WithBindTransformer(t.unsetTransformer(skipNil))
+		defer query.Release()
+
+		batch := t.Session.ContextBatch(retryCtx, gocql.UnloggedBatch)
+		batch.SetConsistency(t.writeConsistency)
+		for _, instance := range instances {
+			additionalVals := maps.Clone(optionVals)
+			maps.Copy(additionalVals, t.rowTTLBindings(instance))
+
+			errBind := batch.BindStructMap(query, instance, additionalVals)
+			if errBind != nil {
+				return errBind
+			}
+		}
+
+		t.Logger.Debug("upsert batch by primary key", zap.String("query", query.String()), zap.Int("rows", batch.Size()))
+
+		exec := t.faultyExec(retryCtx, OperationBatch, batch.Exec)
+		for {
+			err := exec()
+			if err == nil {
+				break
+			}
+
+			var wto *gocql.RequestErrWriteTimeout
+			retryable := errors.As(err, &wto)
+			if !retryable {
+				return err
+			}
+
+			t.Logger.Debug("upsert batch retrying from early write timeout",
+				zap.String("consistency", wto.Consistency.String()),
+				zap.Int("received", wto.Received),
+				zap.Int("blockFor", wto.BlockFor),
+				zap.String("writeType", wto.WriteType),
+				zap.Duration("set_timeout", budget),
+				zap.Duration("execution_time_to_now", time.Since(st)),
+			)
+		}
+
+		// Post-change hooks
+		for _, instance := range instances {
+			errPost := t.runPostHooks(ctx, instance)
+			if errPost != nil {
+				return errPost
+			}
+		}
+
+		return nil
+	})
 }
@@ -106,3 +106,51 @@ func TestWithSimpleIfNotFound(t *testing.T) {
 	require.NotNil(t, fetched, "Should get object back")
 	require.Equal(t, testAddress(3, "Initial Street", "Somerville"), fetched.ShippingAddress, "Should be no change")
 }
+
+// TestUpsertSkipsNilColumns checks nil columns are left untouched, both by tag and by option
+func TestUpsertSkipsNilColumns(t *testing.T) {
+	type SparseOrder struct {
+		OrderID         string   `cql:"order_id"`
+		ShippingAddress *Address `cql:"shipping_address,omitempty"`
+	}
+
+	type PlainOrder struct {
+		OrderID         string   `cql:"order_id"`
+		ShippingAddress *Address `cql:"shipping_address"`
+	}
+
+	// Test globals
+	ctx := context.Background()
+	sparseManager, err := tables.NewTableManager[SparseOrder](ctx,
+		tables.WithCluster(testClusterConfig),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(OrdersTableSpec))
+	require.NoError(t, err, "Should not error starting up")
+	plainManager, err := tables.NewTableManager[PlainOrder](ctx,
+		tables.WithCluster(testClusterConfig),
+		tables.WithKeyspace(TestKeyspace),
+		tables.WithTableSpecification(OrdersTableSpec))
+	require.NoError(t, err, "Should not error starting up")
+
+	// Arrange
+	addr := testAddress(1, "Sparse Street", "Somerville")
+	for _, id := range []string{"upsert-skip-nil-1", "upsert-skip-nil-2"} {
+		errInsert := plainManager.Insert(ctx, &PlainOrder{OrderID: id, ShippingAddress: &addr})
+		require.NoError(t, errInsert, "Should not error inserting")
+	}
+
+	// Act
+	errTagged := sparseManager.Upsert(ctx, &SparseOrder{OrderID: "upsert-skip-nil-1"})
+	errOption := plainManager.Upsert(ctx, &PlainOrder{OrderID: "upsert-skip-nil-2"}, tables.WithSkipNilColumns())
+
+	// Assert
+	require.NoError(t, errTagged, "Should not error upserting with omitempty tag")
+	require.NoError(t, errOption, "Should not error upserting with skip option")
+	for _, id := range []string{"upsert-skip-nil-1", "upsert-skip-nil-2"} {
+		fetched, errGet := plainManager.GetByPartitionKey(ctx, id)
+		require.NoError(t, errGet, "Should not error fetching")
+		require.NotNil(t, fetched, "Should get object back")
+		require.NotNil(t, fetched.ShippingAddress, "Nil column should have been left untouched")
+		require.Equal(t, addr, *fetched.ShippingAddress, "Should keep the original value")
+	}
+}
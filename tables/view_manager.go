@@ -3,6 +3,7 @@ package tables
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/scylladb/gocqlx/v3"
 	"github.com/scylladb/gocqlx/v3/qb"
@@ -26,14 +27,6 @@ func NewViewManager[T any](ctx context.Context, options ...ManagerOption) (ViewM
 		}
 	}
 
-	// Execute hooks
-	for _, opt := range options {
-		err := opt.onStart(ctx, params.Keyspace, WithTableSpec(params.TableSpec), WithViewSpec(params.ViewSpec))
-		if err != nil {
-			return nil, fmt.Errorf("error running view manager start hooks: %w", err)
-		}
-	}
-
 	// Validate view table spec
 	errTable := params.TableSpec.Validate()
 	if errTable != nil {
@@ -46,15 +39,9 @@ func NewViewManager[T any](ctx context.Context, options ...ManagerOption) (ViewM
 		return nil, fmt.Errorf("error validating view spec: %w", errView)
 	}
 
-	// Create our session
-	wrappedSession, err := gocqlx.WrapSession(params.SessionFactory(params.Keyspace))
-	if err != nil {
-		return nil, fmt.Errorf("error wrapping session: %w", err)
-	}
-
 	table := params.ViewSpec.ToCQLX()
 
-	return &viewManager[T]{
+	mgr := &viewManager[T]{
 		baseManagerImpl: baseManagerImpl[T]{
 			// Base objects
 			Logger: params.Logger.With(
@@ -65,7 +52,7 @@ func NewViewManager[T any](ctx context.Context, options ...ManagerOption) (ViewM
 
 			// Metadata
 			Name:          params.ViewSpec.Name,
-			Session:       wrappedSession,
+			Session:       gocqlx.Session{Mapper: params.mapper()}, // Connected during startup
 			Table:         table,
 			TableMetadata: table.Metadata(),
 
@@ -73,6 +60,7 @@ func NewViewManager[T any](ctx context.Context, options ...ManagerOption) (ViewM
 			readConsistency:    params.ReadConsistency,
 			qualifiedTableName: params.Keyspace + "." + params.ViewSpec.Name,
 			allColumnNames:     table.Metadata().Columns,
+			lifecycle:          newRecordLifecycle[T](),
 			partitionKeyPredicates: generics.Map(params.ViewSpec.Partitioning, func(i int, c *metadata.PartitioningColumn) qb.Cmp {
 				return qb.Eq(c.Column.Name)
 			}),
@@ -84,8 +72,62 @@ func NewViewManager[T any](ctx context.Context, options ...ManagerOption) (ViewM
 					return qb.Eq(c.Column.Name)
 				}),
 			),
+			clustering:      params.ViewSpec.Clustering,
+			scanBypassCache: params.ScanBypassCache,
+			timeouts:        params.Timeouts.withDefault(params.queryTimeout),
+			queries:         params.ViewSpec.Queries,
+			faults:          params.FaultInjector,
 		},
-	}, nil
+	}
+
+	// Run startup hooks and create our session, which may happen in the background
+	startup, err := runStartup(ctx, mgr.Logger, params.LazyStartup, params.StartupRetry, func(ctx context.Context) (func(), error) {
+		// Execute hooks
+		for _, opt := range options {
+			err := opt.onStart(ctx, params.Keyspace, WithTableSpec(params.TableSpec), WithViewSpec(params.ViewSpec), WithStartupSessionPool(params.SessionPool))
+			if err != nil {
+				return nil, fmt.Errorf("error running view manager start hooks: %w", err)
+			}
+		}
+
+		// Connect our session
+		session, release, err := params.openSession()
+		if err != nil {
+			return nil, fmt.Errorf("error opening session: %w", err)
+		}
+		if errPrepare := prepareQueries(ctx, session, params.ViewSpec.Queries); errPrepare != nil {
+			release()
+			return nil, errPrepare
+		}
+		mgr.Session.Session = session.Session
+		return release, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	mgr.startup = startup
+
+	mgr.keys = newKeyEncoder(params.TableSpec, slices.Concat(mgr.TableMetadata.PartKey, mgr.TableMetadata.SortKey))
+	if params.ReadCoalescing {
+		mgr.coalescer = newReadCoalescer()
+	}
+	if params.Cache != nil {
+		mgr.cache = &recordCache[T]{
+			cache:       params.Cache,
+			prefix:      mgr.qualifiedTableName,
+			keys:        mgr.keys,
+			ttl:         params.CacheTTL,
+			negativeTTL: params.NegativeCacheTTL,
+		}
+	}
+
+	if verifier := params.SchemaVerifier; verifier != nil {
+		mgr.verifySchema = func(ctx context.Context, sess gocqlx.Session) ([]SchemaProblem, error) {
+			return verifier.VerifyView(ctx, sess, params.Keyspace, params.ViewSpec)
+		}
+	}
+
+	return mgr, nil
 }
 
 type viewManager[T any] struct {